@@ -188,6 +188,8 @@ func down(cmd *cobra.Command, args []string) error {
 			ConstructURN: *construct.URN,
 			Name:         name,
 			IacDirectory: constructPath,
+			AwsRegion:    construct.Region(sm.GetState().DefaultRegion),
+			Retry:        stack.DefaultRetryPolicy,
 		}
 		stackReferences = append(stackReferences, stackReference)
 	}
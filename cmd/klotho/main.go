@@ -53,6 +53,9 @@ func cli() int {
 	upCommand := newUpCmd()
 	cleanupFuncs = append(cleanupFuncs, clicommon.SetupCoreCommand(upCommand, &commonCfg.CommonConfig))
 
+	planCommand := newPlanCmd()
+	cleanupFuncs = append(cleanupFuncs, clicommon.SetupCoreCommand(planCommand, &commonCfg.CommonConfig))
+
 	downCommand := newDownCmd()
 	cleanupFuncs = append(cleanupFuncs, clicommon.SetupCoreCommand(downCommand, &commonCfg.CommonConfig))
 
@@ -72,6 +75,7 @@ func cli() int {
 
 	rootCmd.AddCommand(initCommand)
 	rootCmd.AddCommand(upCommand)
+	rootCmd.AddCommand(planCommand)
 	rootCmd.AddCommand(downCommand)
 	rootCmd.AddCommand(irCommand)
 
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/klothoplatform/klotho/pkg/k2/model"
+	"github.com/spf13/cobra"
+)
+
+func newPlanCmd() *cobra.Command {
+	var planCommand = &cobra.Command{
+		Use:   "plan",
+		Short: "Show the resources that would be added, removed or changed by the up command",
+		Long: "Compiles the application and compares the resulting resources against the last deployed state of each " +
+			"stack. Unlike `up --dry-run`, this does not make any calls to the cloud provider.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return up(cmd, args, model.DryRunPlan)
+		},
+	}
+	flags := planCommand.Flags()
+	flags.StringVar(&upConfig.stateDir, "state-directory", "", "State directory")
+	flags.StringVar(&upConfig.debugMode, "debug", "", "Debug mode")
+	flags.IntVar(&upConfig.debugPort, "debug-port", 5678, "Language Host Debug port")
+	return planCommand
+}
@@ -28,7 +28,9 @@ func newUpCmd() *cobra.Command {
 	var upCommand = &cobra.Command{
 		Use:   "up",
 		Short: "Run the up command",
-		RunE:  up,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return up(cmd, args, model.DryRun(commonCfg.dryRun))
+		},
 	}
 	flags := upCommand.Flags()
 	flags.StringVar(&upConfig.stateDir, "state-directory", "", "State directory")
@@ -37,7 +39,7 @@ func newUpCmd() *cobra.Command {
 	return upCommand
 }
 
-func up(cmd *cobra.Command, args []string) error {
+func up(cmd *cobra.Command, args []string, dryRun model.DryRun) error {
 	filePath := args[0]
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		return err
@@ -127,7 +129,7 @@ func up(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("error creating up orchestrator: %w", err)
 	}
 
-	err = o.RunUpCommand(ctx, ir, model.DryRun(commonCfg.dryRun), semaphore.NewWeighted(5))
+	err = o.RunUpCommand(ctx, ir, dryRun, semaphore.NewWeighted(5))
 	if err != nil {
 		return fmt.Errorf("error running up command: %w", err)
 	}
@@ -1,7 +1,12 @@
 package construct
 
+import "strings"
+
 type EdgeData struct {
 	ConnectionType string `yaml:"connection_type,omitempty" json:"connection_type,omitempty"`
+	// OrderingOnly marks an edge that exists purely to order deployment. Such edges are not
+	// required to have an edge template since there is nothing to configure for them.
+	OrderingOnly bool `yaml:"ordering_only,omitempty" json:"ordering_only,omitempty"`
 }
 
 // Equals implements an interface used in [graph_addons.MemoryStore] to determine whether edges are equal
@@ -13,3 +18,15 @@ func (ed EdgeData) Equals(other any) bool {
 
 	return false
 }
+
+// String describes only the fields which are set, so an empty EdgeData is "{}".
+func (ed EdgeData) String() string {
+	var fields []string
+	if ed.ConnectionType != "" {
+		fields = append(fields, "connection_type: "+ed.ConnectionType)
+	}
+	if ed.OrderingOnly {
+		fields = append(fields, "ordering_only")
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
@@ -0,0 +1,181 @@
+package construct
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+)
+
+// CycleError is returned when a graph which must be acyclic (such as the deployment graph) contains one or more cycles.
+type CycleError struct {
+	Cycles [][]ResourceId
+}
+
+func (e *CycleError) Error() string {
+	lines := make([]string, len(e.Cycles))
+	for i, cycle := range e.Cycles {
+		ids := make([]string, len(cycle)+1)
+		for j, id := range cycle {
+			ids[j] = id.String()
+		}
+		ids[len(cycle)] = cycle[0].String()
+		lines[i] = fmt.Sprintf("cycle detected: %s", strings.Join(ids, " -> "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DetectCycles returns one cycle for each strongly connected component of the graph, including self-loops. Each cycle
+// starts at its lowest ID (by [ResourceIdLess]) and does not repeat the starting ID at the end. Cycles are sorted by
+// their starting ID so the result is deterministic.
+func DetectCycles(g Graph) ([][]ResourceId, error) {
+	adj, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]ResourceId, 0, len(adj))
+	for id := range adj {
+		ids = append(ids, id)
+	}
+	sort.Sort(SortedIds(ids))
+
+	// Tarjan's strongly connected components algorithm
+	var (
+		index   = make(map[ResourceId]int, len(adj))
+		lowlink = make(map[ResourceId]int, len(adj))
+		onStack = make(map[ResourceId]bool, len(adj))
+		stack   []ResourceId
+		sccs    [][]ResourceId
+	)
+	var strongConnect func(id ResourceId)
+	strongConnect = func(id ResourceId) {
+		index[id] = len(index)
+		lowlink[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, next := range sortedTargets(adj[id]) {
+			if _, visited := index[next]; !visited {
+				strongConnect(next)
+				lowlink[id] = min(lowlink[id], lowlink[next])
+			} else if onStack[next] {
+				lowlink[id] = min(lowlink[id], index[next])
+			}
+		}
+
+		if lowlink[id] != index[id] {
+			return
+		}
+		var scc []ResourceId
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			scc = append(scc, top)
+			if top == id {
+				break
+			}
+		}
+		if len(scc) > 1 {
+			sccs = append(sccs, scc)
+		} else if _, selfLoop := adj[id][id]; selfLoop {
+			sccs = append(sccs, scc)
+		}
+	}
+	for _, id := range ids {
+		if _, visited := index[id]; !visited {
+			strongConnect(id)
+		}
+	}
+
+	cycles := make([][]ResourceId, len(sccs))
+	for i, scc := range sccs {
+		cycles[i] = cycleInComponent(adj, scc)
+	}
+	sort.Slice(cycles, func(i, j int) bool {
+		return ResourceIdLess(cycles[i][0], cycles[j][0])
+	})
+	return cycles, nil
+}
+
+// cycleInComponent returns the shortest cycle from the lowest ID in the strongly connected component back to itself.
+func cycleInComponent(adj map[ResourceId]map[ResourceId]Edge, scc []ResourceId) []ResourceId {
+	inScc := make(map[ResourceId]bool, len(scc))
+	for _, id := range scc {
+		inScc[id] = true
+	}
+	sort.Sort(SortedIds(scc))
+	start := scc[0]
+
+	// Breadth-first search from the start back to itself, staying within the component.
+	prev := make(map[ResourceId]ResourceId, len(scc))
+	queue := []ResourceId{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range sortedTargets(adj[current]) {
+			if !inScc[next] {
+				continue
+			}
+			if next == start {
+				cycle := []ResourceId{current}
+				for cycle[0] != start {
+					cycle = append([]ResourceId{prev[cycle[0]]}, cycle...)
+				}
+				return cycle
+			}
+			if _, seen := prev[next]; !seen {
+				prev[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	// Unreachable for a valid strongly connected component
+	return scc
+}
+
+func sortedTargets(edges map[ResourceId]Edge) []ResourceId {
+	targets := make([]ResourceId, 0, len(edges))
+	for t := range edges {
+		targets = append(targets, t)
+	}
+	sort.Sort(SortedIds(targets))
+	return targets
+}
+
+// CheckCycles returns a [CycleError] if the graph contains any cycles.
+func CheckCycles(g Graph) error {
+	cycles, err := DetectCycles(g)
+	if err != nil {
+		return err
+	}
+	if len(cycles) > 0 {
+		return &CycleError{Cycles: cycles}
+	}
+	return nil
+}
+
+// EdgeCycleError converts the [graph.ErrEdgeCreatesCycle] returned by an acyclic graph (such as the deployment graph)
+// when adding source -> target into a [CycleError] with the resources of the cycle the edge would close, starting at
+// the lowest ID. Any other error is returned unchanged.
+func EdgeCycleError(g Graph, source, target ResourceId, err error) error {
+	if !errors.Is(err, graph.ErrEdgeCreatesCycle) {
+		return err
+	}
+	path, pathErr := graph.ShortestPath(g, target, source)
+	if pathErr != nil {
+		return errors.Join(err, pathErr)
+	}
+	cycle := append([]ResourceId{source}, path[:len(path)-1]...)
+	// Start at the lowest ID, as in DetectCycles, so the error doesn't depend on which edge was added last
+	start := 0
+	for i, id := range cycle {
+		if ResourceIdLess(id, cycle[start]) {
+			start = i
+		}
+	}
+	cycle = append(cycle[start:], cycle[:start]...)
+	return &CycleError{Cycles: [][]ResourceId{cycle}}
+}
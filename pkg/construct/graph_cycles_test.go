@@ -0,0 +1,176 @@
+package construct
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeCycleGraph builds a graph from "source -> target" edges (graphtest can't be used here since it imports this package)
+func makeCycleGraph(t *testing.T, g Graph, edges ...string) Graph {
+	for _, e := range edges {
+		var source, target ResourceId
+		s, tgt, _ := strings.Cut(e, " -> ")
+		require.NoError(t, source.Parse(s))
+		require.NoError(t, target.Parse(tgt))
+		for _, id := range []ResourceId{source, target} {
+			if err := g.AddVertex(&Resource{ID: id}); err != nil {
+				require.ErrorIs(t, err, graph.ErrVertexAlreadyExists)
+			}
+		}
+		require.NoError(t, g.AddEdge(source, target))
+	}
+	return g
+}
+
+func TestDetectCycles(t *testing.T) {
+	makeGraph := func(edges ...string) Graph {
+		return makeCycleGraph(t, NewGraph(), edges...)
+	}
+	tests := []struct {
+		name    string
+		graph   Graph
+		want    [][]string
+		wantErr string
+	}{
+		{
+			name: "no cycles",
+			graph: makeGraph(
+				"aws:lambda_function:function -> aws:iam_role:function-role",
+				"aws:iam_role:function-role -> aws:iam_policy:policy",
+			),
+		},
+		{
+			name: "iam cycle",
+			graph: makeGraph(
+				"aws:lambda_function:function -> aws:iam_role:function-role",
+				"aws:iam_role:function-role -> aws:iam_policy:policy",
+				"aws:iam_policy:policy -> aws:lambda_function:function",
+			),
+			want: [][]string{
+				{"aws:iam_policy:policy", "aws:lambda_function:function", "aws:iam_role:function-role"},
+			},
+			wantErr: "cycle detected: aws:iam_policy:policy -> aws:lambda_function:function -> aws:iam_role:function-role -> aws:iam_policy:policy",
+		},
+		{
+			name: "self loop",
+			graph: makeGraph(
+				"aws:lambda_function:function -> aws:iam_role:function-role",
+				"aws:iam_role:function-role -> aws:iam_role:function-role",
+			),
+			want: [][]string{
+				{"aws:iam_role:function-role"},
+			},
+			wantErr: "cycle detected: aws:iam_role:function-role -> aws:iam_role:function-role",
+		},
+		{
+			name: "multiple independent cycles",
+			graph: makeGraph(
+				"P:a -> P:b",
+				"P:b -> P:a",
+				"P:b -> P:c",
+				"P:c -> P:d",
+				"P:d -> P:e",
+				"P:e -> P:c",
+			),
+			want: [][]string{
+				{"P:a", "P:b"},
+				{"P:c", "P:d", "P:e"},
+			},
+			wantErr: "cycle detected: P:a -> P:b -> P:a\ncycle detected: P:c -> P:d -> P:e -> P:c",
+		},
+		{
+			name: "shortest cycle in component",
+			graph: makeGraph(
+				"P:a -> P:b",
+				"P:b -> P:c",
+				"P:c -> P:a",
+				"P:a -> P:c",
+			),
+			want: [][]string{
+				{"P:a", "P:c"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			got, err := DetectCycles(tt.graph)
+			require.NoError(err)
+			gotStr := make([][]string, len(got))
+			for i, cycle := range got {
+				gotStr[i] = make([]string, len(cycle))
+				for j, id := range cycle {
+					gotStr[i][j] = id.String()
+				}
+			}
+			if tt.want == nil {
+				assert.Empty(gotStr)
+			} else {
+				assert.Equal(tt.want, gotStr)
+			}
+
+			err = CheckCycles(tt.graph)
+			switch {
+			case tt.want == nil:
+				assert.NoError(err)
+			case tt.wantErr != "":
+				assert.EqualError(err, tt.wantErr)
+			default:
+				assert.Error(err)
+			}
+		})
+	}
+}
+
+func TestEdgeCycleError(t *testing.T) {
+	tests := []struct {
+		name    string
+		edges   []string
+		add     string
+		wantErr string
+	}{
+		{
+			name:    "closes cycle",
+			edges:   []string{"P:a -> P:b", "P:b -> P:c"},
+			add:     "P:c -> P:a",
+			wantErr: "cycle detected: P:a -> P:b -> P:c -> P:a",
+		},
+		{
+			name:    "starts at lowest id",
+			edges:   []string{"P:c -> P:a", "P:a -> P:b"},
+			add:     "P:b -> P:c",
+			wantErr: "cycle detected: P:a -> P:b -> P:c -> P:a",
+		},
+		{
+			name:  "no cycle",
+			edges: []string{"P:a -> P:b"},
+			add:   "P:a -> P:c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeCycleGraph(t, NewAcyclicGraph(), tt.edges...)
+			var source, target ResourceId
+			s, tgt, _ := strings.Cut(tt.add, " -> ")
+			require.NoError(t, source.Parse(s))
+			require.NoError(t, target.Parse(tgt))
+			if _, err := g.Vertex(target); err != nil {
+				require.NoError(t, g.AddVertex(&Resource{ID: target}))
+			}
+
+			err := EdgeCycleError(g, source, target, g.AddEdge(source, target))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var cycleErr *CycleError
+			require.ErrorAs(t, err, &cycleErr)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
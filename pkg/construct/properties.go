@@ -111,6 +111,62 @@ func (p Properties) Equals(other any) (equal bool) {
 	return equal
 }
 
+// Clone returns a deep copy of the properties, so that modifying nested maps, lists or sets of the copy
+// does not modify p.
+func (p Properties) Clone() Properties {
+	if p == nil {
+		return Properties{}
+	}
+	c := make(Properties, len(p))
+	for k, v := range p {
+		c[k] = cloneValue(v)
+	}
+	return c
+}
+
+func cloneValue(v any) any {
+	if hs, ok := v.(set.HashedSet[string, any]); ok {
+		c := set.HashedSet[string, any]{Hasher: hs.Hasher, Less: hs.Less}
+		for _, val := range hs.M {
+			c.Add(cloneValue(val))
+		}
+		return c
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), cloneElem(iter.Value()))
+		}
+		return c.Interface()
+
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			c.Index(i).Set(cloneElem(rv.Index(i)))
+		}
+		return c.Interface()
+	}
+	return v
+}
+
+// cloneElem clones a map or slice element, keeping nil elements as the zero value of the element type.
+func cloneElem(v reflect.Value) reflect.Value {
+	c := reflect.ValueOf(cloneValue(v.Interface()))
+	if !c.IsValid() {
+		return reflect.Zero(v.Type())
+	}
+	return c
+}
+
 func (p Properties) SetProperty(pathStr string, value any) error {
 	path, err := p.PropertyPath(pathStr)
 	if err != nil {
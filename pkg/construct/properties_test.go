@@ -359,6 +359,36 @@ func TestResource_Properties_ops(t *testing.T) {
 	}
 }
 
+func TestProperties_Clone(t *testing.T) {
+	assert := assert.New(t)
+
+	p := Properties{
+		"A": map[string]any{
+			"foo":   "bar",
+			"array": []string{"fox", "bat"},
+		},
+		"B": []any{[]int{1, 2, 3}},
+		"C": "scalar",
+	}
+	c := p.Clone()
+	assert.Equal(p, c)
+
+	r := &Resource{Properties: c}
+	assert.NoError(r.SetProperty("A.foo", "baz"))
+	assert.NoError(r.SetProperty("A.array[0]", "wolf"))
+	assert.NoError(r.RemoveProperty("B[0][1]", nil))
+	assert.NoError(r.SetProperty("C", "changed"))
+
+	assert.Equal(Properties{
+		"A": map[string]any{
+			"foo":   "bar",
+			"array": []string{"fox", "bat"},
+		},
+		"B": []any{[]int{1, 2, 3}},
+		"C": "scalar",
+	}, p, "modifying the clone must not modify the original")
+}
+
 func TestResource_WalkProperties(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -19,11 +19,13 @@ import (
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	kio "github.com/klothoplatform/klotho/pkg/io"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"github.com/klothoplatform/klotho/pkg/provider/aws"
 	"github.com/klothoplatform/klotho/pkg/templates"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"gopkg.in/yaml.v3"
 )
 
@@ -48,6 +50,32 @@ var architectureEngineCfg struct {
 	constraints string
 	outputDir   string
 	globalTag   string
+	prune       bool
+	logLevel    string
+	logFormat   string
+	concurrency int
+	// lambdaLayers are layer ARNs added to every Lambda function in the app
+	lambdaLayers []string
+	// codeSigningProfile is the signing profile version ARN that every Lambda function's code must be signed by
+	codeSigningProfile string
+	// routeFunctions is a YAML file of API routes to give their own, separately sized, Lambda function
+	routeFunctions string
+	// quotaCheck is whether to warn or error when the solution exceeds account quotas, or empty to not check
+	quotaCheck string
+	// quotaLimits overrides the default account quota of resource types
+	quotaLimits map[string]int
+	// dashboard is whether to add a CloudWatch dashboard of the app's Lambda functions and RDS instances
+	dashboard bool
+	// strict fails the run on warnings, including quota violations in "warn" mode
+	strict bool
+	// target is a resource to solve on its own, along with everything it depends on
+	target string
+	// ignoreDeps are deployment dependencies ('source -> target') left out of the cycle check
+	ignoreDeps []string
+	// vpcEndpoints adds VPC endpoints for the AWS services used in the solution
+	vpcEndpoints bool
+	// splitKB partitions the knowledge base's edges by provider for provider-scoped edge lookups
+	splitKB bool
 }
 
 var getValidEdgeTargetsCfg struct {
@@ -104,6 +132,21 @@ func (em *EngineMain) AddEngineCli(root *cobra.Command) {
 	flags.StringVarP(&architectureEngineCfg.constraints, "constraints", "c", "", "Constraints file")
 	flags.StringVarP(&architectureEngineCfg.outputDir, "output-dir", "o", "", "Output directory")
 	flags.StringVarP(&architectureEngineCfg.globalTag, "global-tag", "t", "", "Global tag")
+	flags.BoolVar(&architectureEngineCfg.prune, "prune-unreachable", false, "Remove resources not connected to any requested resource")
+	flags.StringVar(&architectureEngineCfg.logLevel, "engine-log-level", "", "Minimum level of engine diagnostic logs (debug, info, warn, error)")
+	flags.StringVar(&architectureEngineCfg.logFormat, "engine-log-format", "", "Format of engine diagnostic logs (json, console)")
+	flags.IntVar(&architectureEngineCfg.concurrency, "concurrency", 1, "Maximum number of resources made operational at once")
+	flags.StringSliceVar(&architectureEngineCfg.lambdaLayers, "lambda-layer", nil, "Lambda layer ARN to add to every function in the app (repeatable)")
+	flags.StringVar(&architectureEngineCfg.codeSigningProfile, "code-signing-profile", "", "Signing profile version ARN that every Lambda function's code must be signed by")
+	flags.StringVar(&architectureEngineCfg.quotaCheck, "quota-check", "", "Check the solution against account quotas, either warning (warn) or failing (error) when exceeded")
+	flags.StringToIntVar(&architectureEngineCfg.quotaLimits, "quota-limit", nil, "Account quota for a resource type, overriding the default (for example aws:elastic_ip=10)")
+	flags.BoolVar(&architectureEngineCfg.dashboard, "dashboard", false, "Add a CloudWatch dashboard with metrics for each Lambda function and RDS instance")
+	flags.BoolVar(&architectureEngineCfg.strict, "strict", false, "Treat warnings, such as pruned resources and quota violations, as errors")
+	flags.StringVar(&architectureEngineCfg.routeFunctions, "route-functions", "", "YAML file of API routes to split into their own Lambda functions, with their timeout and memory size")
+	flags.StringVar(&architectureEngineCfg.target, "target", "", "Only solve this resource and the resources it depends on (for example aws:lambda_function:api). The output is partial: do not deploy it to the stack of the whole application")
+	flags.StringArrayVar(&architectureEngineCfg.ignoreDeps, "ignore-dependency", nil, "Deployment dependency ('source -> target') to leave out of the cycle check, to break a false cycle")
+	flags.BoolVar(&architectureEngineCfg.vpcEndpoints, "vpc-endpoints", false, "Add VPC endpoints for the AWS services the app uses, so resources in a VPC reach them privately")
+	flags.BoolVar(&architectureEngineCfg.splitKB, "split-kb-by-provider", false, "Index the knowledge base's edges by provider, for multi-provider knowledge bases")
 
 	getPossibleEdgesCmd := &cobra.Command{
 		Use:     "GetValidEdgeTargets",
@@ -134,6 +177,32 @@ func (em *EngineMain) AddEngine() error {
 	return nil
 }
 
+// engineLogger returns a logger for the engine's diagnostics, or nil to use the global logger
+// if neither the level nor the format is configured.
+func engineLogger(level, format string) (*zap.Logger, error) {
+	if level == "" && format == "" {
+		return nil, nil
+	}
+	opts := logging.LogOpts{
+		Verbose:  commonCfg.Verbose > 0,
+		Color:    "auto",
+		Encoding: format,
+	}
+	if level != "" {
+		lvl, err := zapcore.ParseLevel(level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid engine log level: %w", err)
+		}
+		opts.Level = &lvl
+	}
+	switch format {
+	case "", "json", "console":
+	default:
+		return nil, fmt.Errorf("invalid engine log format %q", format)
+	}
+	return opts.NewLogger(), nil
+}
+
 type resourceInfo struct {
 	Classifications []string          `json:"classifications"`
 	DisplayName     string            `json:"displayName"`
@@ -233,7 +302,7 @@ func (em *EngineMain) Run(ctx context.Context, req *SolveRequest) (int, solution
 	returnCode := 0
 	var engErrs []engine_errs.EngineError
 
-	log := zap.S().Named("engine")
+	log := logging.GetLogger(em.Engine.withLogger(ctx)).Named("engine").Sugar()
 
 	log.Info("Running engine")
 	sol, err := em.Engine.Run(ctx, req)
@@ -320,9 +389,42 @@ func (em *EngineMain) RunEngine(cmd *cobra.Command, args []string) (exitCode int
 		internalError(err)
 		return
 	}
+	em.Engine.Log, err = engineLogger(architectureEngineCfg.logLevel, architectureEngineCfg.logFormat)
+	if err != nil {
+		internalError(err)
+		return
+	}
+	em.Engine.Concurrency = architectureEngineCfg.concurrency
+	em.Engine.Strict = architectureEngineCfg.strict
+	if architectureEngineCfg.splitKB {
+		kb, ok := em.Engine.Kb.(*knowledgebase.KnowledgeBase)
+		if !ok {
+			internalError(fmt.Errorf("cannot split knowledge base of type %T by provider", em.Engine.Kb))
+			return
+		}
+		if err := kb.SplitByProvider(); err != nil {
+			internalError(fmt.Errorf("could not split knowledge base by provider: %w", err))
+			return
+		}
+	}
 
 	context := &SolveRequest{
-		GlobalTag: architectureEngineCfg.globalTag,
+		GlobalTag:          architectureEngineCfg.globalTag,
+		PruneUnreachable:   architectureEngineCfg.prune,
+		VpcEndpoints:       architectureEngineCfg.vpcEndpoints,
+		LambdaLayers:       architectureEngineCfg.lambdaLayers,
+		CodeSigningProfile: architectureEngineCfg.codeSigningProfile,
+		Dashboard:          architectureEngineCfg.dashboard,
+		QuotaCheck:         architectureEngineCfg.quotaCheck,
+		QuotaLimits:        architectureEngineCfg.quotaLimits,
+	}
+	for _, dep := range architectureEngineCfg.ignoreDeps {
+		var edge construct.SimpleEdge
+		if err := edge.UnmarshalText([]byte(dep)); err != nil {
+			internalError(fmt.Errorf("invalid ignored dependency %q: %w", dep, err))
+			return
+		}
+		context.IgnoredDependencies = append(context.IgnoredDependencies, edge)
 	}
 
 	if architectureEngineCfg.inputGraph != "" {
@@ -357,8 +459,40 @@ func (em *EngineMain) RunEngine(cmd *cobra.Command, args []string) (exitCode int
 		context.Constraints = runConstraints
 	}
 
+	if architectureEngineCfg.provider == "aws" && architectureEngineCfg.routeFunctions != "" {
+		log.Info("Loading route functions")
+		routesF, err := os.Open(architectureEngineCfg.routeFunctions)
+		if err != nil {
+			internalError(err)
+			return
+		}
+		defer routesF.Close()
+		var routes []aws.RouteFunction
+		err = yaml.NewDecoder(routesF).Decode(&routes)
+		if err != nil {
+			internalError(fmt.Errorf("failed to decode route functions: %w", err))
+			return
+		}
+		context.RouteFunctions = routes
+	}
+
+	if architectureEngineCfg.target != "" {
+		var target construct.ResourceId
+		err = target.Parse(architectureEngineCfg.target)
+		if err != nil {
+			engErrs = append(engErrs, engine_errs.InvalidConfigError{Option: "target", Err: err})
+			exitCode = 1
+			return
+		}
+		context.Target = target
+		log.Warnf(
+			"Only solving %s and its dependencies: the output is partial, and deploying it to the application's stack deletes every resource outside of it",
+			target,
+		)
+	}
+
 	// len(engErrs) == 0 at this point so overwriting it is safe
-	// All other assignments prior are via 'internalError' and return
+	// All other assignments prior are via 'internalError' (or an invalid option) and return
 	exitCode, sol, engErrs := em.Run(cmd.Context(), context)
 	if exitCode == 1 {
 		return
@@ -467,20 +601,21 @@ func (em *EngineMain) GetValidEdgeTargets(cmd *cobra.Command, args []string) err
 }
 
 func writeDebugGraphs(sol solution.Solution) {
+	log := logging.GetLogger(sol.Context()).Named("engine").Sugar()
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
 		err := GraphToSVG(sol.KnowledgeBase(), sol.DataflowGraph(), "dataflow")
 		if err != nil {
-			zap.S().Named("engine").Errorf("failed to write dataflow graph: %w", err)
+			log.Errorf("failed to write dataflow graph: %w", err)
 		}
 	}()
 	go func() {
 		defer wg.Done()
 		err := GraphToSVG(sol.KnowledgeBase(), sol.DeploymentGraph(), "iac")
 		if err != nil {
-			zap.S().Named("engine").Errorf("failed to write iac graph: %w", err)
+			log.Errorf("failed to write iac graph: %w", err)
 		}
 	}()
 	wg.Wait()
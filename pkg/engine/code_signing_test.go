@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_CodeSigning(t *testing.T) {
+	const profile = "arn:aws:signer:us-east-1:123456789012:/signing-profiles/app/abcde12345"
+	own := graphtest.ParseId(t, "aws:code_signing_config:own")
+	tests := []struct {
+		name    string
+		profile string
+		// want is the code signing config of each function
+		want    map[string]construct.ResourceId
+		wantErr bool
+	}{
+		{
+			name:    "every function is signed",
+			profile: profile,
+			want: map[string]construct.ResourceId{
+				"aws:lambda_function:a": graphtest.ParseId(t, "aws:code_signing_config:app-code-signing-config"),
+				"aws:lambda_function:b": own,
+			},
+		},
+		{
+			name: "no profile",
+			want: map[string]construct.ResourceId{
+				"aws:lambda_function:b": own,
+			},
+		},
+		{
+			name:    "invalid profile",
+			profile: "arn:aws:lambda:us-east-1:123456789012:layer:shared:1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main := EngineMain{}
+			require.NoError(t, main.AddEngine())
+
+			req := &SolveRequest{GlobalTag: "app", CodeSigningProfile: tt.profile}
+			for _, id := range []string{"aws:lambda_function:a", "aws:lambda_function:b", own.String()} {
+				req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+					Operator: constraints.AddConstraintOperator,
+					Node:     graphtest.ParseId(t, id),
+				})
+			}
+			// b keeps the code signing config it has of its own
+			req.Constraints.Edges = append(req.Constraints.Edges, constraints.EdgeConstraint{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: graphtest.ParseId(t, "aws:lambda_function:b"), Target: own},
+			})
+			req.Constraints.Resources = append(req.Constraints.Resources, constraints.ResourceConstraint{
+				Operator: constraints.EqualsConstraintOperator,
+				Target:   own,
+				Property: "SigningProfileVersionArns",
+				Value:    []any{"arn:aws:signer:us-east-1:123456789012:/signing-profiles/b/fghij67890"},
+			})
+			sol, err := main.Engine.Run(context.Background(), req)
+			if tt.wantErr {
+				var configErr engine_errs.InvalidConfigError
+				require.ErrorAs(t, err, &configErr)
+				assert.Equal(t, "code signing profile", configErr.Option)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, id := range []string{"aws:lambda_function:a", "aws:lambda_function:b"} {
+				fn, err := sol.DataflowGraph().Vertex(graphtest.ParseId(t, id))
+				require.NoError(t, err)
+				want, ok := tt.want[id]
+				if !ok {
+					assert.Nil(t, fn.Properties["CodeSigningConfig"], "%s should not be signed", id)
+					continue
+				}
+				assert.Equal(t, want, fn.Properties["CodeSigningConfig"], id)
+			}
+			if tt.profile != "" {
+				config, err := sol.DataflowGraph().Vertex(tt.want["aws:lambda_function:a"])
+				require.NoError(t, err)
+				assert.Equal(t, []any{tt.profile}, config.Properties["SigningProfileVersionArns"])
+			}
+		})
+	}
+}
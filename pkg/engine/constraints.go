@@ -3,10 +3,12 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/dominikbraun/graph"
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
 	"github.com/klothoplatform/klotho/pkg/engine/reconciler"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
@@ -126,9 +128,11 @@ func applyApplicationConstraint(ctx solution.Solution, constraint constraints.Ap
 // - MustExistConstraintOperator, the edge is added to the working state construct graph
 // - MustNotExistConstraintOperator, the edge is removed from the working state construct graph if the source and targets refer to klotho constructs. Otherwise the action fails
 //
-// The following operators are handled during path selection, so any existing paths must be
+// The following operators add the edge like MustExistConstraintOperator and are also handled during path selection
 // - MustContainConstraintOperator, the constraint is applied to the edge before edge expansion, so when we use the knowledgebase to expand it ensures the node in the constraint is present in the expanded path
 // - MustNotContainConstraintOperator, the constraint is applied to the edge before edge expansion, so when we use the knowledgebase to expand it ensures the node in the constraint is not present in the expanded path
+//
+// Soft MustContain/MustNotContain constraints only prefer matching paths and do not fail when none match.
 func applyEdgeConstraint(ctx solution.Solution, constraint constraints.EdgeConstraint) error {
 	for _, id := range []*construct.ResourceId{&constraint.Target.Source, &constraint.Target.Target} {
 		rt, err := ctx.KnowledgeBase().GetResourceTemplate(*id)
@@ -162,7 +166,8 @@ func applyEdgeConstraint(ctx solution.Solution, constraint constraints.EdgeConst
 	case constraints.AddConstraintOperator:
 		return ctx.OperationalView().AddEdge(constraint.Target.Source, constraint.Target.Target)
 
-	case constraints.MustExistConstraintOperator:
+	case constraints.MustExistConstraintOperator,
+		constraints.MustContainConstraintOperator, constraints.MustNotContainConstraintOperator:
 		err := ctx.OperationalView().AddEdge(constraint.Target.Source, constraint.Target.Target)
 		if errors.Is(err, graph.ErrEdgeAlreadyExists) {
 			return nil
@@ -192,3 +197,91 @@ func applySanitization(ctx solution.Solution, constraint *constraints.ResourceCo
 	constraint.Target.Name, err = rt.SanitizeName(constraint.Target.Name)
 	return err
 }
+
+// validateEdgePathConstraints checks the solved dataflow graph against the hard must_not_contain edge constraints.
+// The constraints are applied during path selection, but a forbidden resource can still end up between the source
+// and target if another edge's expansion connects them through it. Rather than listing every path (which grows
+// exponentially), the constraint is unsatisfied when a forbidden resource is both reachable from the source and can
+// reach the target.
+func validateEdgePathConstraints(sol solution.Solution) error {
+	g := sol.DataflowGraph()
+	var adj, pred map[construct.ResourceId]map[construct.ResourceId]graph.Edge[construct.ResourceId]
+	var errs []error
+	for _, constraint := range sol.Constraints().Edges {
+		if constraint.Operator != constraints.MustNotContainConstraintOperator || constraint.IsSoft() {
+			continue
+		}
+		target, err := constraint.ResourceTarget(sol.KnowledgeBase())
+		if err != nil {
+			return err
+		}
+		src, dst := target.Source, target.Target
+		if src.Name == "" || dst.Name == "" {
+			// Global constraints for a type are only applied during path selection
+			continue
+		}
+		if adj == nil {
+			if adj, err = g.AdjacencyMap(); err != nil {
+				return err
+			}
+			if pred, err = g.PredecessorMap(); err != nil {
+				return err
+			}
+		}
+		if _, ok := adj[src]; !ok {
+			continue
+		}
+		if _, ok := adj[dst]; !ok {
+			continue
+		}
+
+		// Paths end at the target and start at the source, so neither search continues past the other endpoint.
+		fromSrc := searchFrom(adj, src, dst)
+		toDst := searchFrom(pred, dst, src)
+		var through []construct.ResourceId
+		for id := range fromSrc {
+			if _, ok := toDst[id]; ok && id != src && id != dst && constraint.PathContainsNode([]construct.ResourceId{id}) {
+				through = append(through, id)
+			}
+		}
+		if len(through) == 0 {
+			continue
+		}
+		sort.Sort(construct.SortedIds(through))
+		// The path goes from the source to the forbidden resource, then on to the target.
+		var path []construct.ResourceId
+		for id := through[0]; id != src; id = fromSrc[id] {
+			path = append([]construct.ResourceId{fromSrc[id]}, path...)
+		}
+		for id := through[0]; id != dst; id = toDst[id] {
+			path = append(path, id)
+		}
+		path = append(path, dst)
+		errs = append(errs, engine_errs.UnsatisfiedConstraintError{Constraint: &constraint, Path: path})
+	}
+	return errors.Join(errs...)
+}
+
+// searchFrom runs a breadth-first search from start along the edges of adj without continuing past stop. It returns
+// each resource reached, mapped to the resource it was first reached from (start maps to itself).
+func searchFrom(
+	adj map[construct.ResourceId]map[construct.ResourceId]graph.Edge[construct.ResourceId],
+	start, stop construct.ResourceId,
+) map[construct.ResourceId]construct.ResourceId {
+	parent := map[construct.ResourceId]construct.ResourceId{start: start}
+	queue := []construct.ResourceId{start}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == stop {
+			continue
+		}
+		for next := range adj[id] {
+			if _, seen := parent[next]; !seen {
+				parent[next] = id
+				queue = append(queue, next)
+			}
+		}
+	}
+	return parent
+}
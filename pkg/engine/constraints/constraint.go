@@ -51,6 +51,8 @@ type (
 	ConstraintScope string
 	// ConstraintOperator is an enum that represents the different operators that can be applied to a constraint
 	ConstraintOperator string
+	// ConstraintStrength is an enum that represents whether a constraint must be satisfied or is only a preference
+	ConstraintStrength string
 
 	ConstraintList []Constraint
 
@@ -70,13 +72,21 @@ const (
 	ResourceConstraintScope    ConstraintScope = "resource"
 	OutputConstraintScope      ConstraintScope = "output"
 
-	MustExistConstraintOperator    ConstraintOperator = "must_exist"
-	MustNotExistConstraintOperator ConstraintOperator = "must_not_exist"
-	AddConstraintOperator          ConstraintOperator = "add"
-	ImportConstraintOperator       ConstraintOperator = "import"
-	RemoveConstraintOperator       ConstraintOperator = "remove"
-	ReplaceConstraintOperator      ConstraintOperator = "replace"
-	EqualsConstraintOperator       ConstraintOperator = "equals"
+	MustExistConstraintOperator      ConstraintOperator = "must_exist"
+	MustNotExistConstraintOperator   ConstraintOperator = "must_not_exist"
+	AddConstraintOperator            ConstraintOperator = "add"
+	ImportConstraintOperator         ConstraintOperator = "import"
+	RemoveConstraintOperator         ConstraintOperator = "remove"
+	ReplaceConstraintOperator        ConstraintOperator = "replace"
+	EqualsConstraintOperator         ConstraintOperator = "equals"
+	MustContainConstraintOperator    ConstraintOperator = "must_contain"
+	MustNotContainConstraintOperator ConstraintOperator = "must_not_contain"
+
+	// HardConstraintStrength constraints must be satisfied, otherwise the run fails. This is the default.
+	HardConstraintStrength ConstraintStrength = "hard"
+	// SoftConstraintStrength constraints are preferences which are followed when possible, but do not fail the
+	// run when they cannot be satisfied.
+	SoftConstraintStrength ConstraintStrength = "soft"
 )
 
 func (cs ConstraintList) MarshalYAML() (interface{}, error) {
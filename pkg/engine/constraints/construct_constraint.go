@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/klothoplatform/klotho/pkg/collectionutil"
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 )
 
@@ -25,6 +26,10 @@ type (
 		Target     construct.ResourceId `yaml:"target" json:"target"`
 		Type       string               `yaml:"type" json:"type"`
 		Attributes map[string]any       `yaml:"attributes" json:"attributes"`
+		// RequiredAttributes are classifications (eg. serverless, highly_available) that the resource the construct
+		// expands into must have itself. Unlike [ConstructConstraint.Attributes], they cannot be satisfied by
+		// additional resources in the expansion.
+		RequiredAttributes []string `yaml:"required_attributes" json:"required_attributes"`
 	}
 )
 
@@ -44,6 +49,12 @@ func (constraint *ConstructConstraint) IsSatisfied(ctx ConstraintGraph) bool {
 		if constraint.Type != "" && res.ID.Type != constraint.Type {
 			return false
 		}
+		classification := ctx.GetClassification(res.ID)
+		for _, attribute := range constraint.RequiredAttributes {
+			if !collectionutil.Contains(classification.Is, attribute) {
+				return false
+			}
+		}
 		return true
 	}
 	return false
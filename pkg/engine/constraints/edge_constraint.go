@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
 )
 
 type (
@@ -21,11 +22,18 @@ type (
 	//  node: aws:rds_proxy:my_proxy
 	//
 	// The end result of this should be a path of klotho:execution_unit:my_compute -> aws:rds_proxy:my_proxy -> klotho:orm:my_orm with N intermediate nodes to satisfy the path's expansion
+	//
+	// Setting `strength: soft` makes the constraint a preference: paths which satisfy it are chosen when there are any,
+	// otherwise the edge is expanded as if the constraint did not exist.
 
 	EdgeConstraint struct {
 		Operator ConstraintOperator `yaml:"operator" json:"operator"`
 		Target   Edge               `yaml:"target" json:"target"`
 		Data     construct.EdgeData `yaml:"data" json:"data"`
+		// Node is the resource the edge's path must (or must not) contain for the must_contain and
+		// must_not_contain operators. Resources in the path match if they are of the same type.
+		Node     construct.ResourceId `yaml:"node,omitempty" json:"node,omitempty"`
+		Strength ConstraintStrength   `yaml:"strength,omitempty" json:"strength,omitempty"`
 	}
 )
 
@@ -62,6 +70,15 @@ func (constraint *EdgeConstraint) IsSatisfied(ctx ConstraintGraph) bool {
 	if err != nil {
 		return false
 	}
+	if constraint.Operator == MustNotContainConstraintOperator {
+		// Every path must avoid the node, since any one of them containing it means the node is between the resources
+		for _, path := range paths {
+			if constraint.PathContainsNode(resourceIds(path)) {
+				return false
+			}
+		}
+		return len(paths) > 0
+	}
 	for _, path := range paths {
 		if constraint.checkSatisfication(path, ctx) {
 			return true
@@ -76,10 +93,51 @@ func (constraint *EdgeConstraint) checkSatisfication(path []*construct.Resource,
 		return len(path) > 0
 	case RemoveConstraintOperator, MustNotExistConstraintOperator:
 		return len(path) == 0
+	case MustContainConstraintOperator:
+		return constraint.PathContainsNode(resourceIds(path))
+	}
+	return false
+}
+
+// IsSoft returns whether the constraint is only a preference which does not need to be satisfied.
+func (constraint *EdgeConstraint) IsSoft() bool {
+	return constraint.Strength == SoftConstraintStrength
+}
+
+// PathContainsNode returns whether any of the resources in the path are of the type of the constraint's Node.
+func (constraint *EdgeConstraint) PathContainsNode(path []construct.ResourceId) bool {
+	for _, id := range path {
+		if id.QualifiedTypeName() == constraint.Node.QualifiedTypeName() {
+			return true
+		}
 	}
 	return false
 }
 
+// ResourceTarget returns the constraint's source and target as the IDs of the resources the engine creates for them,
+// with their names sanitized by their resource templates.
+func (constraint *EdgeConstraint) ResourceTarget(kb knowledgebase.TemplateKB) (Edge, error) {
+	target := constraint.Target
+	for _, id := range []*construct.ResourceId{&target.Source, &target.Target} {
+		rt, err := kb.GetResourceTemplate(*id)
+		if err != nil {
+			return target, err
+		}
+		if id.Name, err = rt.SanitizeName(id.Name); err != nil {
+			return target, err
+		}
+	}
+	return target, nil
+}
+
+func resourceIds(path []*construct.Resource) []construct.ResourceId {
+	ids := make([]construct.ResourceId, len(path))
+	for i, res := range path {
+		ids[i] = res.ID
+	}
+	return ids
+}
+
 func (constraint *EdgeConstraint) Validate() error {
 	if constraint.Target.Source == constraint.Target.Target {
 		return fmt.Errorf("edge constraint must not have a source and target be the same node")
@@ -87,9 +145,24 @@ func (constraint *EdgeConstraint) Validate() error {
 	if (constraint.Target.Source == construct.ResourceId{} || constraint.Target.Target == construct.ResourceId{}) {
 		return fmt.Errorf("edge constraint must have a source and target defined")
 	}
+	switch constraint.Strength {
+	case "", HardConstraintStrength, SoftConstraintStrength:
+	default:
+		return fmt.Errorf("edge constraint has invalid strength %q, must be %q or %q",
+			constraint.Strength, HardConstraintStrength, SoftConstraintStrength)
+	}
+	switch constraint.Operator {
+	case MustContainConstraintOperator, MustNotContainConstraintOperator:
+		if constraint.Node.IsZero() {
+			return fmt.Errorf("edge constraint with operator %s must have a node defined", constraint.Operator)
+		}
+	}
 	return nil
 }
 
 func (constraint *EdgeConstraint) String() string {
+	if !constraint.Node.IsZero() {
+		return fmt.Sprintf("EdgeConstraint{Operator: %s, Target: %s, Node: %s}", constraint.Operator, constraint.Target, constraint.Node)
+	}
 	return fmt.Sprintf("EdgeConstraint{Operator: %s, Target: %s}", constraint.Operator, constraint.Target)
 }
@@ -1,12 +1,14 @@
 package engine
 
 import (
+	"fmt"
 	"testing"
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
 	"github.com/klothoplatform/klotho/pkg/engine/constraints"
 	"github.com/klothoplatform/klotho/pkg/engine/enginetesting"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -94,3 +96,121 @@ func TestApplyConstraints(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEdgePathConstraints(t *testing.T) {
+	forbidProxy := func(strength constraints.ConstraintStrength) constraints.Constraints {
+		return constraints.Constraints{
+			Edges: []constraints.EdgeConstraint{
+				{
+					Operator: constraints.MustNotContainConstraintOperator,
+					Target: constraints.Edge{
+						Source: graphtest.ParseId(t, "aws:lambda_function:fn"),
+						Target: graphtest.ParseId(t, "aws:rds_instance:db"),
+					},
+					Node:     graphtest.ParseId(t, "aws:rds_proxy:proxy"),
+					Strength: strength,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		init        []any
+		constraints constraints.Constraints
+		wantErr     bool
+	}{
+		{
+			name:        "direct path",
+			init:        []any{"aws:lambda_function:fn -> aws:rds_instance:db"},
+			constraints: forbidProxy(constraints.HardConstraintStrength),
+		},
+		{
+			name: "proxy in path",
+			init: []any{
+				"aws:lambda_function:fn -> aws:rds_proxy:db-proxy",
+				"aws:rds_proxy:db-proxy -> aws:rds_instance:db",
+			},
+			constraints: forbidProxy(constraints.HardConstraintStrength),
+			wantErr:     true,
+		},
+		{
+			name: "proxy in another path",
+			init: []any{
+				"aws:lambda_function:fn -> aws:rds_instance:db",
+				"aws:lambda_function:fn -> aws:rds_proxy:db-proxy",
+				"aws:rds_proxy:db-proxy -> aws:rds_instance:db",
+			},
+			constraints: forbidProxy(constraints.HardConstraintStrength),
+			wantErr:     true,
+		},
+		{
+			name: "soft constraint",
+			init: []any{
+				"aws:lambda_function:fn -> aws:rds_proxy:db-proxy",
+				"aws:rds_proxy:db-proxy -> aws:rds_instance:db",
+			},
+			constraints: forbidProxy(constraints.SoftConstraintStrength),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := enginetesting.NewTestSolution()
+			ctx.UseEmptyTemplates()
+			ctx.LoadState(t, tt.init...)
+			ctx.Constr = tt.constraints
+
+			err := validateEdgePathConstraints(ctx)
+			if tt.wantErr {
+				var unsatisfied engine_errs.UnsatisfiedConstraintError
+				require.ErrorAs(t, err, &unsatisfied)
+				require.Contains(t, unsatisfied.Path, graphtest.ParseId(t, "aws:rds_proxy:db-proxy"))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateEdgePathConstraints_ManyPaths(t *testing.T) {
+	// A chain of diamonds has 2^layers paths between its ends, which is far too many to list.
+	const layers = 40
+	var init []any
+	prev := "aws:lambda_function:fn"
+	for i := 0; i < layers; i++ {
+		next := fmt.Sprintf("aws:sqs_queue:q%d", i)
+		init = append(init,
+			fmt.Sprintf("%s -> aws:sns_topic:a%d", prev, i),
+			fmt.Sprintf("%s -> aws:sns_topic:b%d", prev, i),
+			fmt.Sprintf("aws:sns_topic:a%d -> %s", i, next),
+			fmt.Sprintf("aws:sns_topic:b%d -> %s", i, next),
+		)
+		prev = next
+	}
+	init = append(init,
+		prev+" -> aws:rds_instance:db",
+		"aws:sns_topic:a3 -> aws:rds_proxy:db-proxy",
+		"aws:rds_proxy:db-proxy -> aws:sqs_queue:q10",
+	)
+
+	ctx := enginetesting.NewTestSolution()
+	ctx.UseEmptyTemplates()
+	ctx.LoadState(t, init...)
+	ctx.Constr = constraints.Constraints{
+		Edges: []constraints.EdgeConstraint{{
+			Operator: constraints.MustNotContainConstraintOperator,
+			Target: constraints.Edge{
+				Source: graphtest.ParseId(t, "aws:lambda_function:fn"),
+				Target: graphtest.ParseId(t, "aws:rds_instance:db"),
+			},
+			Node: graphtest.ParseId(t, "aws:rds_proxy:proxy"),
+		}},
+	}
+
+	err := validateEdgePathConstraints(ctx)
+	var unsatisfied engine_errs.UnsatisfiedConstraintError
+	require.ErrorAs(t, err, &unsatisfied)
+	path := unsatisfied.Path
+	require.Contains(t, path, graphtest.ParseId(t, "aws:rds_proxy:db-proxy"))
+	require.Equal(t, graphtest.ParseId(t, "aws:lambda_function:fn"), path[0])
+	require.Equal(t, graphtest.ParseId(t, "aws:rds_instance:db"), path[len(path)-1])
+}
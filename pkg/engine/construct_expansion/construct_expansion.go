@@ -16,6 +16,8 @@ type (
 	ExpansionSet struct {
 		Construct  *construct.Resource
 		Attributes []string
+		// RequiredAttributes must be in the classification of the resource the construct is directly mapped to
+		RequiredAttributes []string
 	}
 
 	ExpansionSolution struct {
@@ -35,7 +37,7 @@ type (
 // All dependencies are copied over to the resource graph
 // If a dependency in the working state included a construct, the engine copies the dependency to all directly linked resources
 func (ctx *ConstructExpansionContext) ExpandConstruct(res *construct.Resource, constraints []constraints.ConstructConstraint) ([]ExpansionSolution, error) {
-	if res.ID.IsAbstractResource() {
+	if !res.ID.IsAbstractResource() {
 		return nil, fmt.Errorf("unable to expand construct %s, resource is not an abstract construct", res.ID)
 	}
 	zap.S().Debugf("Expanding construct %s", res.ID)
@@ -43,9 +45,11 @@ func (ctx *ConstructExpansionContext) ExpandConstruct(res *construct.Resource, c
 	attributes := make(map[string]any)
 	for _, constructConstraint := range constraints {
 		if constructConstraint.Target == res.ID {
-			constructType = constructConstraint.Type
-			if constructType != "" && constructType != constructConstraint.Type {
-				return nil, fmt.Errorf("unable to expand construct %s, conflicting types in constraints", res.ID)
+			if constructConstraint.Type != "" {
+				if constructType != "" && constructType != constructConstraint.Type {
+					return nil, fmt.Errorf("unable to expand construct %s, conflicting types in constraints", res.ID)
+				}
+				constructType = constructConstraint.Type
 			}
 			for k, v := range constructConstraint.Attributes {
 				if val, ok := attributes[k]; ok {
@@ -57,7 +61,7 @@ func (ctx *ConstructExpansionContext) ExpandConstruct(res *construct.Resource, c
 			}
 		}
 	}
-	expansionSet := ExpansionSet{Construct: res}
+	expansionSet := ExpansionSet{Construct: res, RequiredAttributes: requiredAttributes(res.ID, constraints)}
 	for attribute := range attributes {
 		expansionSet.Attributes = append(expansionSet.Attributes, attribute)
 	}
@@ -69,6 +73,9 @@ func (ctx *ConstructExpansionContext) findPossibleExpansions(expansionSet Expans
 	var joinedErr error
 	functionality := knowledgebase.GetFunctionality(ctx.Kb, expansionSet.Construct.ID)
 	for _, res := range ctx.Kb.ListResources() {
+		if res.Id().IsAbstractResource() {
+			continue
+		}
 		if constructQualifiedType != "" && res.Id().QualifiedTypeName() != constructQualifiedType {
 			continue
 		}
@@ -76,6 +83,9 @@ func (ctx *ConstructExpansionContext) findPossibleExpansions(expansionSet Expans
 		if !collectionutil.Contains(classifications.Is, string(functionality)) {
 			continue
 		}
+		if !hasAllAttributes(classifications, expansionSet.RequiredAttributes) {
+			continue
+		}
 		unsatisfiedAttributes := []string{}
 		for _, ms := range expansionSet.Attributes {
 			if !collectionutil.Contains(classifications.Is, ms) {
@@ -101,11 +111,39 @@ func (ctx *ConstructExpansionContext) findPossibleExpansions(expansionSet Expans
 		}
 	}
 	if len(possibleExpansions) == 0 {
-		return nil, fmt.Errorf("no expansions found for attributes %v", expansionSet.Attributes)
+		return nil, fmt.Errorf(
+			"no expansions found for attributes %v (required %v)",
+			expansionSet.Attributes, expansionSet.RequiredAttributes,
+		)
 	}
 	return possibleExpansions, nil
 }
 
+// requiredAttributes returns the attributes the constraints require of the resource the construct expands into.
+func requiredAttributes(id construct.ResourceId, constructConstraints []constraints.ConstructConstraint) []string {
+	var required []string
+	for _, constructConstraint := range constructConstraints {
+		if constructConstraint.Target != id {
+			continue
+		}
+		for _, attribute := range constructConstraint.RequiredAttributes {
+			if !collectionutil.Contains(required, attribute) {
+				required = append(required, attribute)
+			}
+		}
+	}
+	return required
+}
+
+func hasAllAttributes(classification knowledgebase.Classification, attributes []string) bool {
+	for _, attribute := range attributes {
+		if !collectionutil.Contains(classification.Is, attribute) {
+			return false
+		}
+	}
+	return true
+}
+
 // findExpansions finds all possible expansions for a given construct and a set of attributes
 // It returns a list of all possible expansions by recursing down and calling itself until
 func (ctx *ConstructExpansionContext) findExpansions(attributes []string, edges []graph.Edge[construct.Resource], baseResource construct.Resource, functionality knowledgebase.Functionality) ([][]graph.Edge[construct.Resource], error) {
@@ -146,3 +184,47 @@ func (ctx *ConstructExpansionContext) findExpansions(attributes []string, edges
 	}
 	return result, nil
 }
+
+// ValidateConstructs checks that every construct in the graph can be expanded into a resource of at least one
+// provider, and one which has the attributes the construct constraints require of it, so that an unsupported
+// construct fails up front instead of part way through solving.
+func ValidateConstructs(kb knowledgebase.TemplateKB, g construct.Graph, constructConstraints []constraints.ConstructConstraint) error {
+	supported := make(map[string]bool)
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if !id.IsAbstractResource() {
+			return nerr
+		}
+		qualifiedType := id.QualifiedTypeName()
+		ok, checked := supported[qualifiedType]
+		if !checked {
+			ok = hasProviderResource(kb, id, nil)
+			supported[qualifiedType] = ok
+		}
+		if !ok {
+			return errors.Join(nerr, fmt.Errorf("construct %s: type %s is not supported by any provider", id, qualifiedType))
+		}
+		if required := requiredAttributes(id, constructConstraints); !hasProviderResource(kb, id, required) {
+			return errors.Join(nerr, fmt.Errorf("construct %s: no provider resource has the required attributes %v", id, required))
+		}
+		return nerr
+	})
+}
+
+// hasProviderResource returns whether any provider has a resource with the construct's functionality
+// and all the attributes.
+func hasProviderResource(kb knowledgebase.TemplateKB, id construct.ResourceId, attributes []string) bool {
+	functionality := knowledgebase.GetFunctionality(kb, id)
+	if functionality == knowledgebase.Unknown {
+		return false
+	}
+	for _, res := range kb.ListResources() {
+		if res.Id().IsAbstractResource() {
+			continue
+		}
+		if collectionutil.Contains(res.Classification.Is, string(functionality)) &&
+			hasAllAttributes(res.Classification, attributes) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package constructexpansion
+
+import (
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/kbtesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandConstruct_RequiredAttributes(t *testing.T) {
+	tests := []struct {
+		name               string
+		requiredAttributes []string
+		want               []string
+		wantErr            bool
+	}{
+		{
+			name: "no required attributes",
+			want: []string{"aws:eks_cluster", "aws:lambda_function"},
+		},
+		{
+			name:               "serverless excludes eks",
+			requiredAttributes: []string{"serverless"},
+			want:               []string{"aws:lambda_function"},
+		},
+		{
+			name:               "unsatisfiable attribute",
+			requiredAttributes: []string{"serverless", "highly_available"},
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			kb := kbtesting.MakeKB(t,
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "klotho:compute",
+					Classification:    knowledgebase.Classification{Is: []string{"compute"}},
+				},
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "aws:lambda_function",
+					Classification:    knowledgebase.Classification{Is: []string{"compute", "serverless"}},
+				},
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "aws:eks_cluster",
+					Classification:    knowledgebase.Classification{Is: []string{"compute", "kubernetes"}},
+				},
+			)
+			ctx := &ConstructExpansionContext{Kb: kb}
+
+			res := &construct.Resource{ID: graphtest.ParseId(t, "klotho:compute:api")}
+			solutions, err := ctx.ExpandConstruct(res, []constraints.ConstructConstraint{
+				{
+					Operator:           constraints.EqualsConstraintOperator,
+					Target:             res.ID,
+					RequiredAttributes: tt.requiredAttributes,
+				},
+			})
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			var got []string
+			for _, sol := range solutions {
+				got = append(got, sol.DirectlyMappedResource.QualifiedTypeName())
+			}
+			assert.ElementsMatch(tt.want, got)
+		})
+	}
+}
+
+func TestValidateConstructs(t *testing.T) {
+	tests := []struct {
+		name               string
+		graph              []any
+		requiredAttributes []string
+		wantErr            string
+	}{
+		{
+			name:  "supported construct",
+			graph: []any{"klotho:compute:api", "aws:s3_bucket:bucket"},
+		},
+		{
+			name:    "construct no provider supports",
+			graph:   []any{"klotho:compute:api", "klotho:queue:jobs"},
+			wantErr: "type klotho:queue is not supported by any provider",
+		},
+		{
+			name:    "construct with unknown type",
+			graph:   []any{"klotho:mystery:thing"},
+			wantErr: "type klotho:mystery is not supported by any provider",
+		},
+		{
+			name:               "required attributes a provider resource has",
+			graph:              []any{"klotho:compute:api"},
+			requiredAttributes: []string{"serverless"},
+		},
+		{
+			name:               "required attributes no provider resource has",
+			graph:              []any{"klotho:compute:api"},
+			requiredAttributes: []string{"serverless", "highly_available"},
+			wantErr:            "no provider resource has the required attributes [serverless highly_available]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			kb := kbtesting.MakeKB(t,
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "klotho:compute",
+					Classification:    knowledgebase.Classification{Is: []string{"compute"}},
+				},
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "klotho:queue",
+					Classification:    knowledgebase.Classification{Is: []string{"messaging"}},
+				},
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "aws:lambda_function",
+					Classification:    knowledgebase.Classification{Is: []string{"compute", "serverless"}},
+				},
+				&knowledgebase.ResourceTemplate{
+					QualifiedTypeName: "aws:s3_bucket",
+					Classification:    knowledgebase.Classification{Is: []string{"storage"}},
+				},
+			)
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+
+			cs := []constraints.ConstructConstraint{
+				{
+					Operator:           constraints.EqualsConstraintOperator,
+					Target:             graphtest.ParseId(t, "klotho:compute:api"),
+					RequiredAttributes: tt.requiredAttributes,
+				},
+			}
+
+			err := ValidateConstructs(kb, g, cs)
+			if tt.wantErr == "" {
+				assert.NoError(err)
+				return
+			}
+			if assert.Error(err) {
+				assert.Contains(err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Dashboard(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	req := &SolveRequest{Dashboard: true, GlobalTag: "app", PruneUnreachable: true}
+	req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+		Operator: constraints.AddConstraintOperator,
+		Node:     fn,
+	})
+	sol, err := main.Engine.Run(context.Background(), req)
+	require.NoError(t, err)
+
+	dashboard, err := sol.DataflowGraph().Vertex(graphtest.ParseId(t, "aws:cloudwatch_dashboard:app-dashboard"))
+	require.NoError(t, err, "the dashboard is added, and not pruned")
+	widgets := dashboard.Properties["DashboardBody"].(map[string]any)["Widgets"].([]any)
+	require.Len(t, widgets, 1)
+	assert.Equal(t, 6, widgets[0].(map[string]any)["Height"])
+
+	_, err = sol.DeploymentGraph().Edge(dashboard.ID, fn)
+	assert.NoError(t, err, "the dashboard is deployed after the function it graphs")
+}
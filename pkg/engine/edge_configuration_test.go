@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/operational_rule"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestEdgeConfigurationIdempotent applies every edge template's configuration rules twice to the same
+// edge and checks that the second pass neither fails nor changes either resource. Edges are re-evaluated
+// whenever their resources change, so a configuration which derives its value from the field it sets
+// (or which conflicts with another rule on the same field) would otherwise keep growing or flip-flopping.
+//
+// Each edge is first configured between two newly created resources. Many rules read fields or neighbours
+// which the resources' own operational rules set (such as a lambda's ExecutionRole), so when that fails the
+// edge is checked within a solution instead: the first solved engine test graph (testdata/*.expect.yaml) which
+// contains it, or else the engine's solution of just the edge.
+func TestEdgeConfigurationIdempotent(t *testing.T) {
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(t, err)
+
+	edges, err := kb.Edges()
+	require.NoError(t, err)
+
+	solvedTests, err := filepath.Glob(filepath.Join("testdata", "*.expect.yaml"))
+	require.NoError(t, err)
+
+	for _, e := range edges {
+		source := construct.ResourceId{Provider: e.Source.Id().Provider, Type: e.Source.Id().Type, Name: "source"}
+		target := construct.ResourceId{Provider: e.Target.Id().Provider, Type: e.Target.Id().Type, Name: "target"}
+		et := kb.GetEdgeTemplate(source, target)
+		if et == nil || !hasConfigurationRules(et) {
+			continue
+		}
+		typePair := fmt.Sprintf("%s -> %s", e.Source.QualifiedTypeName, e.Target.QualifiedTypeName)
+
+		t.Run(typePair, func(t *testing.T) {
+			sol := NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+			for _, id := range []construct.ResourceId{source, target} {
+				res, err := knowledgebase.CreateResource(kb, id)
+				require.NoError(t, err)
+				require.NoError(t, sol.RawView().AddVertex(res))
+			}
+			require.NoError(t, sol.RawView().AddEdge(source, target))
+			edge := construct.Edge{Source: source, Target: target}
+
+			if isolatedErr := applyEdgeConfiguration(sol, et, edge); isolatedErr != nil {
+				sol, edge = solvedTestEdge(t, kb, solvedTests, source, target)
+				if sol == nil {
+					edge = construct.Edge{Source: source, Target: target}
+					sol = solvedEdge(t, kb, edge)
+				}
+				if sol == nil {
+					reason, ok := edgesRequiringContext[typePair]
+					require.True(t, ok, "edge cannot be configured in isolation (%v) or found in a solution", isolatedErr)
+					t.Skip(reason)
+				}
+				require.NoError(t, applyEdgeConfiguration(sol, et, edge), "first configuration failed")
+			}
+			_, listed := edgesRequiringContext[typePair]
+			assert.False(t, listed, "edge is now checked, remove it from edgesRequiringContext")
+
+			before := snapshotProperties(t, sol, edge.Source, edge.Target)
+			require.NoError(t, applyEdgeConfiguration(sol, et, edge), "second configuration failed")
+			assert.Equal(t, before, snapshotProperties(t, sol, edge.Source, edge.Target), "second configuration changed properties")
+		})
+	}
+}
+
+// edgesRequiringContext are the edges which can't be configured between new resources, aren't in any solved engine
+// test graph and don't remain in the solution of just the edge, along with what they need.
+var edgesRequiringContext = map[string]string{
+	"aws:ecs_task_definition -> aws:efs_access_point":     "a container definition, set by the task definition's service",
+	"aws:ecs_task_definition -> aws:efs_mount_target":     "a container definition, set by the task definition's service",
+	"aws:eks_fargate_profile -> aws:eks_cluster":          "the aws-observability namespace, created by expanding a path from the profile",
+	"aws:load_balancer_listener_rule -> aws:target_group": "the rule's Listener, set by the load balancer's path",
+	"aws:route_table -> aws:subnet":                       "the route table's Vpc, set by its route table association",
+	"aws:subnet -> aws:security_group_rule":               "the subnet's CidrBlock, allocated from its VPC",
+	"kubernetes:persistent_volume -> aws:efs_file_system": "the volume's storage class and cluster, set by the pod using it",
+}
+
+// solvedTestEdge loads the first solved engine test graph with an edge between resources of the same types as source
+// and target, returning nil if none contain one.
+func solvedTestEdge(
+	t *testing.T,
+	kb knowledgebase.TemplateKB,
+	paths []string,
+	source, target construct.ResourceId,
+) (*engineSolution, construct.Edge) {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		var ff FileFormat
+		err = yaml.NewDecoder(f).Decode(&ff)
+		f.Close()
+		require.NoError(t, err, path)
+
+		edges, err := ff.Graph.Edges()
+		require.NoError(t, err)
+		for _, e := range edges {
+			if e.Source.QualifiedTypeName() != source.QualifiedTypeName() ||
+				e.Target.QualifiedTypeName() != target.QualifiedTypeName() {
+				continue
+			}
+			sol := NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+			require.NoError(t, sol.LoadGraph(ff.Graph), path)
+			return sol, construct.Edge{Source: e.Source, Target: e.Target}
+		}
+	}
+	return nil, construct.Edge{}
+}
+
+// solvedEdge solves a graph of just the edge, so that the resources are made operational the same way as in a real
+// run, returning nil if the edge does not remain in the solution.
+func solvedEdge(t *testing.T, kb knowledgebase.TemplateKB, edge construct.Edge) *engineSolution {
+	g := construct.NewGraph()
+	require.NoError(t, g.AddVertex(&construct.Resource{ID: edge.Source, Properties: construct.Properties{}}))
+	require.NoError(t, g.AddVertex(&construct.Resource{ID: edge.Target, Properties: construct.Properties{}}))
+	require.NoError(t, g.AddEdge(edge.Source, edge.Target))
+
+	engine := &Engine{Kb: kb}
+	sol, err := engine.Run(context.Background(), &SolveRequest{InitialState: g})
+	if err != nil {
+		t.Logf("solving the edge failed: %v", err)
+		return nil
+	}
+	if _, err := sol.DataflowGraph().Edge(edge.Source, edge.Target); err != nil {
+		return nil
+	}
+	return sol.(*engineSolution)
+}
+
+func hasConfigurationRules(et *knowledgebase.EdgeTemplate) bool {
+	for _, rule := range et.OperationalRules {
+		if len(rule.ConfigurationRules) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// applyEdgeConfiguration applies only the configuration rules of the edge template, mirroring how the
+// operational evaluator resolves each rule's resource before configuring it.
+func applyEdgeConfiguration(sol *engineSolution, et *knowledgebase.EdgeTemplate, edge construct.Edge) error {
+	data := knowledgebase.DynamicValueData{Edge: &edge}
+	for _, rule := range et.OperationalRules {
+		shouldRun, err := operational_rule.EvaluateIfCondition(rule.If, sol, data)
+		if err != nil {
+			return err
+		}
+		if !shouldRun {
+			continue
+		}
+		for _, cfg := range rule.ConfigurationRules {
+			res, err := knowledgebase.ExecuteDecodeAsResourceId(solution.DynamicCtx(sol), cfg.Resource, data)
+			if err != nil {
+				return err
+			}
+			ctx := operational_rule.OperationalRuleContext{
+				Solution: sol,
+				Data:     knowledgebase.DynamicValueData{Edge: data.Edge, Resource: res},
+			}
+			if err := ctx.HandleConfigurationRule(cfg, constraints.AddConstraintOperator); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func snapshotProperties(t *testing.T, sol *engineSolution, ids ...construct.ResourceId) map[string]string {
+	snapshot := make(map[string]string, len(ids))
+	for _, id := range ids {
+		res, err := sol.Dataflow.Vertex(id)
+		require.NoError(t, err)
+		snapshot[id.String()] = fmt.Sprintf("%v", res.Properties)
+	}
+	return snapshot
+}
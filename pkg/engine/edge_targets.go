@@ -14,7 +14,7 @@ import (
 	"github.com/dominikbraun/graph"
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/path_selection"
-	"go.uber.org/zap"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"gopkg.in/yaml.v3"
 )
 
@@ -162,7 +162,9 @@ func (e *Engine) GetValidEdgeTargets(req *GetPossibleEdgesContext) (map[string][
 	if err != nil {
 		return nil, err
 	}
-	solutionCtx := NewSolution(context.TODO(), e.Kb, "", &constraints.Constraints{})
+	ctx := e.withLogger(context.TODO())
+	log := logging.GetLogger(ctx).Sugar()
+	solutionCtx := NewSolution(ctx, e.Kb, "", &constraints.Constraints{})
 	err = solutionCtx.LoadGraph(inputGraph)
 	if err != nil {
 		return nil, err
@@ -265,9 +267,9 @@ func (e *Engine) GetValidEdgeTargets(req *GetPossibleEdgesContext) (map[string][
 				if !previouslCached {
 					isValid, cacheable, _ = e.EdgeCanBeExpanded(solutionCtx, source, target)
 				} else {
-					zap.S().Debugf("Using cached result for %s -> %s: %t", source, target, isValid)
+					log.Debugf("Using cached result for %s -> %s: %t", source, target, isValid)
 				}
-				zap.S().Debugf("valid target: %s -> %s: %t", source, target, isValid)
+				log.Debugf("valid target: %s -> %s: %t", source, target, isValid)
 				results <- &edgeValidity{
 					Source:  source,
 					Target:  target,
@@ -6,8 +6,15 @@ import (
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	constructexpansion "github.com/klothoplatform/klotho/pkg/engine/construct_expansion"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/klothoplatform/klotho/pkg/engine/reconciler"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/logging"
+	"github.com/klothoplatform/klotho/pkg/provider/aws"
+	"github.com/klothoplatform/klotho/pkg/set"
+	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 )
 
@@ -15,6 +22,12 @@ type (
 	// Engine is a struct that represents the object which processes the resource graph and applies constraints
 	Engine struct {
 		Kb knowledgebase.TemplateKB
+		// Log, if set, is used for the engine's diagnostics instead of the logger in the context passed to Run
+		Log *zap.Logger
+		// Concurrency is the maximum number of resources made operational at once. Values less than 2 disable concurrency.
+		Concurrency int
+		// Strict fails the run if there were any warnings, such as resources pruned for being unreachable
+		Strict bool
 	}
 
 	// SolveRequest is a struct that represents the context of the engine
@@ -23,6 +36,33 @@ type (
 		Constraints  constraints.Constraints
 		InitialState construct.Graph
 		GlobalTag    string
+		// PruneUnreachable removes resources that are not connected to any of the requested resources after solving
+		PruneUnreachable bool
+		// Tags are added to every taggable resource in the solution which doesn't already set them
+		Tags map[string]string
+		// IgnoredDependencies are deployment dependencies (source depends on target) left out of the deployment graph,
+		// to break a false cycle. The IaC must be generated with the same dependencies ignored.
+		IgnoredDependencies []construct.SimpleEdge
+		// VpcEndpoints adds VPC endpoints to each VPC for the AWS services used in the solution
+		VpcEndpoints bool
+		// Target, if set, limits the solve to the target and everything it depends on (see [Engine.ScopeToTarget])
+		Target construct.ResourceId
+		// RouteFunctions give API routes their own lambda function, split from the InitialState's function for the
+		// route before solving
+		RouteFunctions []aws.RouteFunction
+		// LambdaLayers are added to every lambda function, ahead of the function's own layers
+		LambdaLayers []string
+		// CodeSigningProfile is the ARN of the signing profile version that every lambda function's code must be
+		// signed by. Functions which already have a code signing config keep it. Empty leaves code signing off.
+		CodeSigningProfile string
+		// Dashboard adds a CloudWatch dashboard of the solution's lambda functions and RDS instances, named after the
+		// GlobalTag
+		Dashboard bool
+		// QuotaCheck checks the solution against the AWS account quotas. In "warn" mode each violation is a warning,
+		// and in "error" mode any violation fails the run. Empty skips the check.
+		QuotaCheck string
+		// QuotaLimits overrides the default quotas (aws.DefaultQuotas) of resource types
+		QuotaLimits map[string]int
 	}
 )
 
@@ -32,8 +72,40 @@ func NewEngine(kb knowledgebase.TemplateKB) *Engine {
 	}
 }
 
+// withLogger returns the context with the engine's [Engine.Log], if set
+func (e *Engine) withLogger(ctx context.Context) context.Context {
+	if e.Log != nil {
+		ctx = logging.WithLogger(ctx, e.Log)
+	}
+	return ctx
+}
+
 func (e *Engine) Run(ctx context.Context, req *SolveRequest) (solution.Solution, error) {
+	ctx = e.withLogger(ctx)
 	sol := NewSolution(ctx, e.Kb, req.GlobalTag, &req.Constraints)
+	sol.propertyEval.Concurrency = e.Concurrency
+	sol.IgnoreDependencies(req.IgnoredDependencies)
+	if !req.Target.IsZero() {
+		if err := e.ScopeToTarget(req, req.Target); err != nil {
+			return sol, engine_errs.InvalidConfigError{Option: "target", Err: err}
+		}
+	}
+	if req.InitialState != nil {
+		if err := constructexpansion.ValidateConstructs(e.Kb, req.InitialState, req.Constraints.Construct); err != nil {
+			return sol, err
+		}
+	}
+	if len(req.RouteFunctions) > 0 {
+		if req.InitialState == nil {
+			return sol, engine_errs.InvalidConfigError{
+				Option: "route functions",
+				Err:    fmt.Errorf("no input graph to split the routes' functions from"),
+			}
+		}
+		if err := aws.SplitRouteFunctions(req.InitialState, &req.Constraints, e.Kb, req.RouteFunctions); err != nil {
+			return sol, engine_errs.InvalidConfigError{Option: "route functions", Err: err}
+		}
+	}
 	err := sol.LoadGraph(req.InitialState)
 	if err != nil {
 		return sol, err
@@ -42,8 +114,156 @@ func (e *Engine) Run(ctx context.Context, req *SolveRequest) (solution.Solution,
 	if err != nil {
 		return sol, err
 	}
+	if err := aws.ApplyAppLayers(sol.DataflowGraph(), req.LambdaLayers); err != nil {
+		return sol, engine_errs.InvalidConfigError{Option: "lambda layers", Err: err}
+	}
+	if req.CodeSigningProfile != "" {
+		name := "code-signing-config"
+		if req.GlobalTag != "" {
+			name = req.GlobalTag + "-code-signing-config"
+		}
+		if err := aws.AddAppCodeSigning(sol, name, req.CodeSigningProfile); err != nil {
+			return sol, engine_errs.InvalidConfigError{Option: "code signing profile", Err: err}
+		}
+	}
 	err = sol.Solve()
-	return sol, err
+	if err != nil {
+		return sol, err
+	}
+	if req.VpcEndpoints || req.Dashboard {
+		// The endpoints and dashboard depend on which resources are in the solution, so they are added once solved
+		// and then solved themselves.
+		if req.VpcEndpoints {
+			if err := aws.AddVpcEndpoints(sol); err != nil {
+				return sol, err
+			}
+		}
+		if req.Dashboard {
+			name := "dashboard"
+			if req.GlobalTag != "" {
+				name = req.GlobalTag + "-dashboard"
+			}
+			if err := aws.AddDashboard(sol, name); err != nil {
+				return sol, fmt.Errorf("failed to add dashboard: %w", err)
+			}
+		}
+		if err := sol.Solve(); err != nil {
+			return sol, err
+		}
+	}
+	if err := sol.ValidateIgnoredDependencies(); err != nil {
+		return sol, err
+	}
+	var warnings Warnings
+	if req.PruneUnreachable {
+		requested, err := req.requestedResources(e.Kb)
+		if err != nil {
+			return sol, err
+		}
+		roots, err := solvedResources(sol, requested)
+		if err != nil {
+			return sol, err
+		}
+		removed, err := reconciler.PruneUnreachable(sol, roots)
+		if err != nil {
+			return sol, err
+		}
+		log := logging.GetLogger(ctx).Named("engine").Sugar()
+		for _, id := range removed {
+			warnings.Add(log, "pruned unreachable resource %s", id)
+		}
+	}
+	if err := validateEdgePathConstraints(sol); err != nil {
+		return sol, err
+	}
+	if err := applyTags(sol, req.Tags); err != nil {
+		return sol, err
+	}
+	if err := aws.CheckLambdaArchitectures(sol.DataflowGraph()); err != nil {
+		return sol, err
+	}
+	if err := aws.CheckS3LifecycleRules(sol.DataflowGraph()); err != nil {
+		return sol, err
+	}
+	if err := aws.CheckEventRuleSchedules(sol.DataflowGraph()); err != nil {
+		return sol, err
+	}
+	if err := checkQuotas(sol, req.QuotaCheck, req.QuotaLimits, &warnings); err != nil {
+		return sol, err
+	}
+	if e.Strict {
+		return sol, warnings.Err()
+	}
+	return sol, nil
+}
+
+// requestedResources returns the resources that were requested, either via the initial state or the constraints.
+// The sanitized IDs are included, since that is how resources created from constraints are named in the solution.
+func (req SolveRequest) requestedResources(kb knowledgebase.TemplateKB) (set.Set[construct.ResourceId], error) {
+	ids := make(set.Set[construct.ResourceId])
+	if req.InitialState != nil {
+		adj, err := req.InitialState.AdjacencyMap()
+		if err != nil {
+			return nil, err
+		}
+		for id := range adj {
+			ids.Add(id)
+		}
+	}
+	for _, c := range req.Constraints.Application {
+		switch c.Operator {
+		case constraints.AddConstraintOperator, constraints.MustExistConstraintOperator, constraints.ImportConstraintOperator:
+			ids.Add(c.Node)
+		case constraints.ReplaceConstraintOperator:
+			ids.Add(c.ReplacementNode)
+		}
+	}
+	for _, c := range req.Constraints.Resources {
+		ids.Add(c.Target)
+	}
+	for _, c := range req.Constraints.Edges {
+		if c.Operator != constraints.RemoveConstraintOperator && c.Operator != constraints.MustNotExistConstraintOperator {
+			ids.Add(c.Target.Source, c.Target.Target)
+		}
+	}
+	for _, id := range ids.ToSlice() {
+		rt, err := kb.GetResourceTemplate(id)
+		if err != nil || rt == nil || id.Name == "" {
+			continue
+		}
+		id.Name, err = rt.SanitizeName(id.Name)
+		if err != nil {
+			return nil, err
+		}
+		ids.Add(id)
+	}
+	return ids, nil
+}
+
+// solvedResources returns the resources in the solution for the requested IDs. Resources may be namespaced while
+// solving (such as a subnet placed in its VPC), so a requested ID without a namespace also matches the namespaced
+// resource.
+func solvedResources(sol solution.Solution, requested set.Set[construct.ResourceId]) (set.Set[construct.ResourceId], error) {
+	ids, err := vertexIds(sol.DataflowGraph())
+	if err != nil {
+		return nil, err
+	}
+	solved := make(set.Set[construct.ResourceId])
+	for id := range requested {
+		if ids.Contains(id) {
+			solved.Add(id)
+			continue
+		}
+		if id.Name == "" || id.Namespace != "" {
+			continue
+		}
+		for candidate := range ids {
+			if id.Matches(candidate) {
+				solved.Add(candidate)
+			}
+		}
+	}
+	return solved, nil
 }
 
 func (req SolveRequest) MarshalYAML() (interface{}, error) {
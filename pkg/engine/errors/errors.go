@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
 )
 
 type (
@@ -24,6 +25,10 @@ const (
 	ConfigInvalidCode   ErrorCode = "config_invalid"
 	EdgeInvalidCode     ErrorCode = "edge_invalid"
 	EdgeUnsupportedCode ErrorCode = "edge_unsupported"
+
+	ConstraintUnsatisfiedCode  ErrorCode = "constraint_unsatisfied"
+	NoPathCode                 ErrorCode = "no_path"
+	ResourceNotOperationalCode ErrorCode = "resource_not_operational"
 )
 
 type InternalError struct {
@@ -46,6 +51,31 @@ func (e InternalError) Unwrap() error {
 	return e.Err
 }
 
+// InvalidConfigError is returned when one of the engine's options, rather than a resource's property, is invalid.
+type InvalidConfigError struct {
+	Option string
+	Err    error
+}
+
+func (e InvalidConfigError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Option, e.Err)
+}
+
+func (e InvalidConfigError) ErrorCode() ErrorCode {
+	return ConfigInvalidCode
+}
+
+func (e InvalidConfigError) ToJSONMap() map[string]any {
+	return map[string]any{
+		"option":           e.Option,
+		"validation_error": e.Err.Error(),
+	}
+}
+
+func (e InvalidConfigError) Unwrap() error {
+	return e.Err
+}
+
 type UnsupportedExpansionErr struct {
 	// ExpandEdge is the overall edge that is being expanded
 	ExpandEdge construct.SimpleEdge
@@ -119,3 +149,95 @@ func (e InvalidPathErr) ToJSONMap() map[string]any {
 	}
 	return m
 }
+
+// UnsatisfiedConstraintError is returned when the solved graph does not satisfy a constraint.
+type UnsatisfiedConstraintError struct {
+	Constraint constraints.Constraint
+	// Path is the path through the graph which violates the constraint, if the constraint applies to paths
+	Path construct.Path
+}
+
+func (e UnsatisfiedConstraintError) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("constraint %s is not satisfied", e.Constraint)
+	}
+	return fmt.Sprintf("constraint %s is not satisfied by path %s", e.Constraint, e.Path)
+}
+
+func (e UnsatisfiedConstraintError) ErrorCode() ErrorCode {
+	return ConstraintUnsatisfiedCode
+}
+
+func (e UnsatisfiedConstraintError) ToJSONMap() map[string]any {
+	m := map[string]any{
+		"constraint": e.Constraint.String(),
+	}
+	if len(e.Path) > 0 {
+		m["path"] = e.Path
+	}
+	return m
+}
+
+// NoPathError is returned when there is no path between two resources, or none which satisfies the path constraints.
+type NoPathError struct {
+	Source construct.ResourceId
+	Target construct.ResourceId
+	// Constraint is the constraint which every candidate path failed, nil if there were no candidate paths
+	Constraint constraints.Constraint
+}
+
+func (e NoPathError) Error() string {
+	if e.Constraint == nil {
+		return fmt.Sprintf("no path from %s to %s", e.Source, e.Target)
+	}
+	return fmt.Sprintf("no path from %s to %s satisfies %s", e.Source, e.Target, e.Constraint)
+}
+
+func (e NoPathError) ErrorCode() ErrorCode {
+	return NoPathCode
+}
+
+func (e NoPathError) ToJSONMap() map[string]any {
+	m := map[string]any{
+		"source": e.Source,
+		"target": e.Target,
+	}
+	if e.Constraint != nil {
+		m["constraint"] = e.Constraint.String()
+	}
+	return m
+}
+
+// NonOperationalResourceError is returned when a resource cannot be made operational, such as when an operational
+// rule requires a resource which is missing and cannot be created.
+type NonOperationalResourceError struct {
+	Resource construct.ResourceId
+	// Property is the property whose operational rule failed, empty for edge rules
+	Property string
+	Err      error
+}
+
+func (e NonOperationalResourceError) Error() string {
+	if e.Property == "" {
+		return fmt.Sprintf("resource %s is not operational: %v", e.Resource, e.Err)
+	}
+	return fmt.Sprintf("resource %s is not operational (property %s): %v", e.Resource, e.Property, e.Err)
+}
+
+func (e NonOperationalResourceError) ErrorCode() ErrorCode {
+	return ResourceNotOperationalCode
+}
+
+func (e NonOperationalResourceError) ToJSONMap() map[string]any {
+	m := map[string]any{
+		"resource": e.Resource,
+	}
+	if e.Property != "" {
+		m["property"] = e.Property
+	}
+	return m
+}
+
+func (e NonOperationalResourceError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// ignoringDependencies is a deployment graph which drops the ignored dependencies instead of adding them, so a false
+// cycle between resources never enters the deployment order (where adding it fails with a [construct.CycleError]).
+type ignoringDependencies struct {
+	construct.Graph
+
+	deps []construct.SimpleEdge
+
+	mu sync.Mutex
+	// ignored records, for each ignored dependency, whether the solution tried to add it
+	ignored map[construct.SimpleEdge]bool
+}
+
+// IgnoreDependencies leaves the dependencies (source depends on target) out of the deployment graph, to break a false
+// cycle which would otherwise fail the solution. It must be called before the graph is loaded, and checked with
+// [engineSolution.ValidateIgnoredDependencies] once it is solved.
+func (s *engineSolution) IgnoreDependencies(deps []construct.SimpleEdge) {
+	if len(deps) == 0 {
+		return
+	}
+	g := &ignoringDependencies{
+		Graph:   s.Deployment,
+		deps:    deps,
+		ignored: make(map[construct.SimpleEdge]bool, len(deps)),
+	}
+	for _, dep := range deps {
+		g.ignored[dep] = false
+	}
+	s.Deployment = g
+}
+
+func (g *ignoringDependencies) AddEdge(
+	source, target construct.ResourceId,
+	options ...func(*graph.EdgeProperties),
+) error {
+	if g.ignore(source, target) {
+		return nil
+	}
+	return g.Graph.AddEdge(source, target, options...)
+}
+
+func (g *ignoringDependencies) UpdateEdge(
+	source, target construct.ResourceId,
+	options ...func(*graph.EdgeProperties),
+) error {
+	if g.ignore(source, target) {
+		return nil
+	}
+	return g.Graph.UpdateEdge(source, target, options...)
+}
+
+func (g *ignoringDependencies) ignore(source, target construct.ResourceId) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	edge := construct.SimpleEdge{Source: source, Target: target}
+	if _, ok := g.ignored[edge]; !ok {
+		return false
+	}
+	g.ignored[edge] = true
+	return true
+}
+
+// ValidateIgnoredDependencies checks that each ignored dependency was a dependency of the solution, and that the
+// dependent resource does not reference its dependency in its properties: a referenced resource must be deployed first,
+// so that dependency cannot be ignored.
+func (s *engineSolution) ValidateIgnoredDependencies() error {
+	g, ok := s.Deployment.(*ignoringDependencies)
+	if !ok {
+		return nil
+	}
+	var errs error
+	for _, dep := range g.deps {
+		if !g.ignored[dep] {
+			errs = errors.Join(errs, fmt.Errorf("ignored dependency %s is not a dependency in the solution", dep))
+			continue
+		}
+		r, err := s.Dataflow.Vertex(dep.Source)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		err = r.WalkProperties(func(path construct.PropertyPath, nerr error) error {
+			v, _ := path.Get()
+			switch v := v.(type) {
+			case construct.ResourceId:
+				if v == dep.Target {
+					return errors.Join(nerr, fmt.Errorf("cannot ignore dependency %s: %s references it", dep, path))
+				}
+			case construct.PropertyRef:
+				if v.Resource == dep.Target {
+					return errors.Join(nerr, fmt.Errorf("cannot ignore dependency %s: %s references it", dep, path))
+				}
+			}
+			return nerr
+		})
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/infra/iac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_IgnoredDependencies(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	api := graphtest.ParseId(t, "aws:rest_api:api")
+	a := graphtest.ParseId(t, "aws:api_resource:api:a")
+	b := graphtest.ParseId(t, "aws:api_resource:api:b")
+	newRequest := func() *SolveRequest {
+		return &SolveRequest{
+			InitialState: graphtest.MakeGraph(t, construct.NewGraph(),
+				&construct.Resource{ID: api, Properties: construct.Properties{}},
+				&construct.Resource{ID: a, Properties: construct.Properties{"RestApi": api, "FullPath": "/a"}},
+				&construct.Resource{ID: b, Properties: construct.Properties{"RestApi": api, "FullPath": "/b"}},
+				"aws:rest_api:api -> aws:api_resource:api:a",
+				"aws:rest_api:api -> aws:api_resource:api:b",
+				// sibling resources don't depend on each other, but edges both ways make them a cycle
+				"aws:api_resource:api:a -> aws:api_resource:api:b",
+				"aws:api_resource:api:b -> aws:api_resource:api:a",
+			),
+		}
+	}
+
+	_, err := main.Engine.Run(context.Background(), newRequest())
+	require.EqualError(t, err,
+		"cycle detected: aws:api_resource:api:a -> aws:api_resource:api:b -> aws:api_resource:api:a")
+
+	req := newRequest()
+	req.IgnoredDependencies = []construct.SimpleEdge{{Source: a, Target: b}}
+	sol, err := main.Engine.Run(context.Background(), req)
+	require.NoError(t, err)
+	// only the deployment order is affected, the resources stay connected
+	_, err = sol.DataflowGraph().Edge(b, a)
+	assert.NoError(t, err)
+	_, err = sol.DeploymentGraph().Edge(a, b)
+	assert.ErrorIs(t, err, graph.ErrEdgeNotFound)
+	_, err = sol.DeploymentGraph().Edge(b, a)
+	assert.NoError(t, err)
+
+	// the solution renders, since the ignored dependency no longer makes the deployment order a cycle
+	plugin := iac.Plugin{Config: &iac.PulumiConfig{AppName: "test"}, KB: main.Engine.Kb}
+	files, err := plugin.Translate(sol)
+	require.NoError(t, err)
+	var index string
+	for _, f := range files {
+		if f.Path() != "index.ts" {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		_, err := f.WriteTo(buf)
+		require.NoError(t, err)
+		index = buf.String()
+	}
+	aDecl := strings.Index(index, "const a = new aws.apigateway.Resource(")
+	bDecl := strings.Index(index, "const b = new aws.apigateway.Resource(")
+	require.True(t, aDecl >= 0 && bDecl >= 0, "both resources should be rendered:\n%s", index)
+	assert.Less(t, aDecl, bDecl, "b depends on a, so a is rendered first")
+
+	req = newRequest()
+	req.IgnoredDependencies = []construct.SimpleEdge{{Source: a, Target: b}, {Source: a, Target: api}}
+	_, err = main.Engine.Run(context.Background(), req)
+	assert.ErrorContains(t, err, "cannot ignore dependency aws:api_resource:api:a -> aws:rest_api:api: RestApi references it")
+}
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_LambdaLayers(t *testing.T) {
+	layer := func(i int) string {
+		return fmt.Sprintf("arn:aws:lambda:us-east-1:123456789012:layer:layer%d:1", i)
+	}
+	tests := []struct {
+		name    string
+		layers  []string
+		want    []any
+		wantErr bool
+	}{
+		{
+			name:   "adds layers",
+			layers: []string{layer(1), layer(2)},
+			want:   []any{layer(1), layer(2)},
+		},
+		{
+			name:    "too many layers",
+			layers:  []string{layer(1), layer(2), layer(3), layer(4), layer(5), layer(6)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main := EngineMain{}
+			require.NoError(t, main.AddEngine())
+
+			fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+			req := &SolveRequest{LambdaLayers: tt.layers}
+			req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+				Operator: constraints.AddConstraintOperator,
+				Node:     fn,
+			})
+			sol, err := main.Engine.Run(context.Background(), req)
+			if tt.wantErr {
+				var configErr engine_errs.InvalidConfigError
+				require.ErrorAs(t, err, &configErr)
+				assert.Equal(t, engine_errs.ConfigInvalidCode, configErr.ErrorCode())
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := sol.DataflowGraph().Vertex(fn)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res.Properties["Layers"])
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRun_Log(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	engineCore, engineLogs := observer.New(zap.DebugLevel)
+	main.Engine.Log = zap.New(engineCore)
+	globalCore, globalLogs := observer.New(zap.DebugLevel)
+	defer zap.ReplaceGlobals(zap.New(globalCore))()
+
+	req := &SolveRequest{PruneUnreachable: true}
+	for _, id := range []string{"aws:lambda_function:fn", "aws:s3_bucket:bucket"} {
+		req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+			Operator: constraints.AddConstraintOperator,
+			Node:     graphtest.ParseId(t, id),
+		})
+	}
+	req.Constraints.Edges = append(req.Constraints.Edges, constraints.EdgeConstraint{
+		Operator: constraints.MustExistConstraintOperator,
+		Target: constraints.Edge{
+			Source: graphtest.ParseId(t, "aws:lambda_function:fn"),
+			Target: graphtest.ParseId(t, "aws:s3_bucket:bucket"),
+		},
+	})
+	_, err := main.Engine.Run(debug.WithDebugDir(context.Background(), t.TempDir()), req)
+	require.NoError(t, err)
+
+	assert.NotZero(t, engineLogs.Len(), "the engine's diagnostics should go to its logger")
+	for _, entry := range globalLogs.All() {
+		t.Errorf("unexpected log to the global logger: %s", entry.Message)
+	}
+}
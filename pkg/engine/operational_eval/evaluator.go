@@ -29,6 +29,10 @@ type (
 
 		currentKey *Key
 
+		// Concurrency is the maximum number of resources whose vertices are built at once in [Evaluator.AddResources].
+		// Values less than 2 build them serially.
+		Concurrency int
+
 		log *zap.SugaredLogger
 	}
 
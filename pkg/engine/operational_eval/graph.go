@@ -3,6 +3,7 @@ package operational_eval
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/dominikbraun/graph"
 	construct "github.com/klothoplatform/klotho/pkg/construct"
@@ -33,20 +34,58 @@ func newGraph(log *zap.Logger) Graph {
 }
 
 func (eval *Evaluator) AddResources(rs ...*construct.Resource) error {
-	changes := newChanges()
-	var errs error
-	for _, res := range rs {
+	results := make([]graphChanges, len(rs))
+	resErrs := make([]error, len(rs))
+	addResource := func(i int) {
+		res := rs[i]
 		tmpl, err := eval.Solution.KnowledgeBase().GetResourceTemplate(res.ID)
 		if err != nil {
-			errs = errors.Join(errs, err)
-			continue
+			resErrs[i] = err
+			return
 		}
 		rvs, err := eval.resourceVertices(res, tmpl)
 		if err != nil {
-			errs = errors.Join(errs, fmt.Errorf("could not add resource eval vertices %s: %w", res.ID, err))
+			resErrs[i] = fmt.Errorf("could not add resource eval vertices %s: %w", res.ID, err)
+			return
+		}
+		results[i] = rvs
+	}
+
+	if eval.Concurrency > 1 && len(rs) > 1 {
+		// Make sure the logger is initialized before it's used from multiple goroutines
+		_ = eval.Log()
+
+		// Building the vertices only reads from the solution, so each resource can be done independently.
+		// The results are merged and enqueued serially (in input order) below so that the graph mutations
+		// are identical to the serial path.
+		sem := make(chan struct{}, eval.Concurrency)
+		var wg sync.WaitGroup
+		for i := range rs {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				addResource(i)
+			}(i)
+		}
+		wg.Wait()
+	} else {
+		for i := range rs {
+			addResource(i)
+		}
+	}
+
+	changes := newChanges()
+	var errs error
+	for i := range rs {
+		if resErrs[i] != nil {
+			errs = errors.Join(errs, resErrs[i])
 			continue
 		}
-		changes.Merge(rvs)
+		changes.Merge(results[i])
 	}
 	if errs != nil {
 		return errs
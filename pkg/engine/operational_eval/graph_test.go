@@ -1,6 +1,7 @@
 package operational_eval
 
 import (
+	"fmt"
 	"testing"
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
@@ -10,6 +11,8 @@ import (
 	"github.com/klothoplatform/klotho/pkg/knowledgebase/properties"
 	"github.com/klothoplatform/klotho/pkg/set"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEvaluator_resourceVertices(t *testing.T) {
@@ -177,3 +180,75 @@ func TestEvaluator_RemoveResource(t *testing.T) {
 		})
 	}
 }
+
+func addResourcesWithConcurrency(t testing.TB, concurrency int, ids []construct.ResourceId) *Evaluator {
+	tmpl := &knowledgebase.ResourceTemplate{
+		Properties: map[string]knowledgebase.Property{
+			"prop1": &properties.StringProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{Path: "prop1"},
+			},
+			"prop2": &properties.StringProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{Path: "prop2"},
+			},
+		},
+	}
+	testSol := enginetesting.NewTestSolution()
+	testSol.KB.On("GetResourceTemplate", mock.Anything).Return(tmpl, nil)
+
+	resources := make([]*construct.Resource, len(ids))
+	for i, id := range ids {
+		resources[i] = &construct.Resource{ID: id, Properties: construct.Properties{"prop1": "value1"}}
+		if err := testSol.RawView().AddVertex(resources[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	eval := NewEvaluator(testSol)
+	eval.Concurrency = concurrency
+	if err := eval.AddResources(resources...); err != nil {
+		t.Fatal(err)
+	}
+	return eval
+}
+
+func makeResourceIds(n int) []construct.ResourceId {
+	ids := make([]construct.ResourceId, n)
+	for i := range ids {
+		ids[i] = construct.ResourceId{Provider: "p", Type: "t", Name: fmt.Sprintf("r%d", i)}
+	}
+	return ids
+}
+
+func TestEvaluator_AddResources_Concurrency(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ids := makeResourceIds(50)
+
+	serial := addResourcesWithConcurrency(t, 1, ids)
+	concurrent := addResourcesWithConcurrency(t, 8, ids)
+
+	serialAdj, err := serial.graph.AdjacencyMap()
+	require.NoError(err)
+	concurrentAdj, err := concurrent.graph.AdjacencyMap()
+	require.NoError(err)
+	require.Len(serialAdj, 2*len(ids))
+	assert.Equal(serialAdj, concurrentAdj)
+
+	for k := range serialAdj {
+		want, err := serial.graph.Vertex(k)
+		require.NoError(err)
+		got, err := concurrent.graph.Vertex(k)
+		require.NoError(err)
+		assert.Equal(want, got)
+	}
+}
+
+func BenchmarkEvaluator_AddResources(b *testing.B) {
+	ids := makeResourceIds(500)
+	for _, concurrency := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				addResourcesWithConcurrency(b, concurrency, ids)
+			}
+		})
+	}
+}
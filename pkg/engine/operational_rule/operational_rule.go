@@ -10,6 +10,7 @@ import (
 	"github.com/klothoplatform/klotho/pkg/engine/reconciler"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"github.com/klothoplatform/klotho/pkg/set"
 	"go.uber.org/zap"
 )
@@ -119,7 +120,7 @@ func (ctx *OperationalRuleContext) SetData(data knowledgebase.DynamicValueData)
 // For array properties, each element must match at least one step selector and non-matching
 // elements will be removed.
 func (ctx OperationalRuleContext) CleanProperty(step knowledgebase.OperationalStep) error {
-	log := zap.L().With(
+	log := logging.GetLogger(ctx.Solution.Context()).With(
 		zap.String("op", "op_rule"),
 		zap.String("property", ctx.Property.Details().Path),
 		zap.String("resource", ctx.Data.Resource.String()),
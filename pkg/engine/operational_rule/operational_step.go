@@ -7,11 +7,11 @@ import (
 
 	"github.com/dominikbraun/graph"
 	construct "github.com/klothoplatform/klotho/pkg/construct"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
 	"github.com/klothoplatform/klotho/pkg/engine/reconciler"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
 	"github.com/klothoplatform/klotho/pkg/logging"
-	"go.uber.org/zap"
 )
 
 func (ctx OperationalRuleContext) HandleOperationalStep(step knowledgebase.OperationalStep) error {
@@ -59,7 +59,14 @@ func (ctx OperationalRuleContext) HandleOperationalStep(step knowledgebase.Opera
 	}
 
 	if step.FailIfMissing {
-		return fmt.Errorf("operational resource '%s' missing when required", resource.ID)
+		err := engine_errs.NonOperationalResourceError{
+			Resource: resource.ID,
+			Err:      fmt.Errorf("%d of %d required %s resources are missing", step.NumNeeded-numValues, step.NumNeeded, step.Direction),
+		}
+		if ctx.Property != nil {
+			err.Property = ctx.Property.Details().Path
+		}
+		return err
 	}
 
 	action := operationalResourceAction{
@@ -261,7 +268,7 @@ func (ctx OperationalRuleContext) SetField(resource, fieldResource *construct.Re
 	path := ctx.Property.Details().Path
 	propVal, err := resource.GetProperty(path)
 	if err != nil {
-		zap.S().Debugf("property %s not found on resource %s", path, resource.ID)
+		logging.GetLogger(ctx.Solution.Context()).Sugar().Debugf("property %s not found on resource %s", path, resource.ID)
 	}
 	var propertyValue any
 	propertyValue = fieldResource.ID
@@ -285,7 +292,7 @@ func (ctx OperationalRuleContext) SetField(resource, fieldResource *construct.Re
 		if err != nil {
 			return err
 		}
-		zap.S().Infof("Removing old field value for '%s' (%s) for %s", path, currResId, fieldResource.ID)
+		logging.GetLogger(ctx.Solution.Context()).Sugar().Infof("Removing old field value for '%s' (%s) for %s", path, currResId, fieldResource.ID)
 		// Remove the old field value if it's unused
 		err = reconciler.RemoveResource(ctx.Solution, currResId, false)
 		if err != nil {
@@ -10,7 +10,7 @@ import (
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
-	"go.uber.org/zap"
+	"github.com/klothoplatform/klotho/pkg/logging"
 )
 
 type (
@@ -96,7 +96,7 @@ func checkCandidatesValidity(
 		errs = errors.Join(errs, err)
 	}
 	if !valid {
-		zap.S().Debugf("candidate %s is not valid as target", resource.ID)
+		logging.GetLogger(ctx.Context()).Sugar().Debugf("candidate %s is not valid as target", resource.ID)
 		return false, errs
 	}
 
@@ -106,7 +106,7 @@ func checkCandidatesValidity(
 		errs = errors.Join(errs, err)
 	}
 	if !valid {
-		zap.S().Debugf("candidate %s is not valid as source", resource.ID)
+		logging.GetLogger(ctx.Context()).Sugar().Debugf("candidate %s is not valid as source", resource.ID)
 		return false, errs
 	}
 	return true, errs
@@ -166,7 +166,7 @@ func checkAsTargetValidity(
 				if err != nil {
 					// dont return error because it just means that the property isnt set and we can make the
 					// resource valid
-					zap.S().Debugf(
+					logging.GetLogger(ctx.Context()).Sugar().Debugf(
 						"no resource available from resource %s from property ref %s: %v",
 						resource.ID, ps.PropertyReference, err,
 					)
@@ -216,7 +216,7 @@ func checkAsSourceValidity(
 				if err != nil {
 					// dont return error because it just means that the property isnt set and we can make the
 					// resource valid
-					zap.S().Debugf(
+					logging.GetLogger(ctx.Context()).Sugar().Debugf(
 						"no resource available from resource %s from property ref %s: %v",
 						resource.ID, ps.PropertyReference, err,
 					)
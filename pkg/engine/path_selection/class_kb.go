@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"slices"
 
-	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/knowledgebase"
 )
 
+// EdgesFromFunc returns the edge templates from the type, such as [knowledgebase.KnowledgeBase.GetEdgesWithSource].
+type EdgesFromFunc func(source construct.ResourceId) ([]*knowledgebase.EdgeTemplate, error)
+
 func ClassPaths(
 	kb knowledgebase.Graph,
 	start, end string,
@@ -19,13 +22,32 @@ func ClassPaths(
 	if err != nil {
 		return err
 	}
+	edgesFrom := func(source construct.ResourceId) ([]*knowledgebase.EdgeTemplate, error) {
+		var templates []*knowledgebase.EdgeTemplate
+		for _, edge := range adjacencyMap[source.QualifiedTypeName()] {
+			templates = append(templates, edge.Properties.Data.(*knowledgebase.EdgeTemplate))
+		}
+		return templates, nil
+	}
+	return ClassPathsFrom(kb, edgesFrom, start, end, classification, cb)
+}
+
+// ClassPathsFrom is like [ClassPaths], but looks up the edges from each type with edgesFrom instead of the graph's
+// adjacency map.
+func ClassPathsFrom(
+	kb knowledgebase.Graph,
+	edgesFrom EdgesFromFunc,
+	start, end string,
+	classification string,
+	cb func([]string) error,
+) error {
 	startTmpl, err := kb.Vertex(start)
 	if err != nil {
 		return fmt.Errorf("failed to find start template: %w", err)
 	}
 	return classPaths(
 		kb,
-		adjacencyMap,
+		edgesFrom,
 		start, end,
 		classification,
 		cb,
@@ -40,7 +62,7 @@ var (
 
 func classPaths(
 	kb knowledgebase.Graph,
-	adjacencyMap map[string]map[string]graph.Edge[string],
+	edgesFrom EdgesFromFunc,
 	start, end string,
 	classification string,
 	cb func([]string) error,
@@ -48,23 +70,25 @@ func classPaths(
 	classificationSatisfied bool,
 ) error {
 	last := currentPath[len(currentPath)-1]
-	frontier := adjacencyMap[last]
+	var lastId construct.ResourceId
+	if err := lastId.Parse(last); err != nil {
+		return err
+	}
+	frontier, err := edgesFrom(lastId)
+	if err != nil {
+		return fmt.Errorf("failed to find edges from %s: %w", last, err)
+	}
 	if len(frontier) == 0 {
 		return nil
 	}
 	var errs []error
-	for next := range frontier {
+	for _, edgeTmpl := range frontier {
+		next := edgeTmpl.Target.QualifiedTypeName()
 		if slices.Contains(currentPath, next) {
 			// Prevent infinite looping, since the knowledge base can be cyclic
 			continue
 		}
 		nextClassificationSatisfied := classificationSatisfied
-		edge, err := kb.Edge(last, next)
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		edgeTmpl := edge.Properties.Data.(*knowledgebase.EdgeTemplate)
 		if edgeTmpl.DirectEdgeOnly {
 			continue
 		}
@@ -98,7 +122,7 @@ func classPaths(
 		} else if next != end {
 			err := classPaths(
 				kb,
-				adjacencyMap,
+				edgesFrom,
 				start, end,
 				classification,
 				cb,
@@ -14,8 +14,8 @@ import (
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/dot"
 	"github.com/klothoplatform/klotho/pkg/engine/debug"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"github.com/klothoplatform/klotho/pkg/set"
-	"go.uber.org/zap"
 )
 
 // seenFiles is used to keep track of which files have been added to by this execution
@@ -25,13 +25,14 @@ var seenFiles = make(set.Set[string])
 var seenFilesLock = new(sync.Mutex)
 
 func writeGraph(ctx context.Context, input ExpansionInput, working, result construct.Graph) {
+	log := logging.GetLogger(ctx).Sugar()
 	dir := "selection"
 	if debugDir := debug.GetDebugDir(ctx); debugDir != "" {
 		dir = filepath.Join(debugDir, "selection")
 	}
 	err := os.MkdirAll(dir, 0755)
 	if err != nil && !errors.Is(err, os.ErrExist) {
-		zap.S().Warnf("Could not create folder for selection diagram: %v", err)
+		log.Warnf("Could not create folder for selection diagram: %v", err)
 		return
 	}
 
@@ -41,7 +42,7 @@ func writeGraph(ctx context.Context, input ExpansionInput, working, result const
 
 	f, err := os.OpenFile(fprefix+".gv", os.O_RDWR|os.O_CREATE, 0755)
 	if err != nil {
-		zap.S().Errorf("could not create file %s: %v", fprefix, err)
+		log.Errorf("could not create file %s: %v", fprefix, err)
 		return
 	}
 	defer f.Close()
@@ -51,7 +52,7 @@ func writeGraph(ctx context.Context, input ExpansionInput, working, result const
 		seenFiles.Add(f.Name())
 		err := f.Truncate(0)
 		if err != nil {
-			zap.S().Errorf("could not truncate file %s: %v", f.Name(), err)
+			log.Errorf("could not truncate file %s: %v", f.Name(), err)
 			seenFilesLock.Unlock()
 			return
 		}
@@ -61,7 +62,7 @@ func writeGraph(ctx context.Context, input ExpansionInput, working, result const
 	dotContent := new(bytes.Buffer)
 	_, err = io.Copy(dotContent, f)
 	if err != nil {
-		zap.S().Errorf("could not read file %s: %v", f.Name(), err)
+		log.Errorf("could not read file %s: %v", f.Name(), err)
 		return
 	}
 
@@ -81,7 +82,7 @@ func writeGraph(ctx context.Context, input ExpansionInput, working, result const
 
 	err = graphToDOTCluster(input.Classification, working, result, dotContent)
 	if err != nil {
-		zap.S().Errorf("could not render graph for %s: %v", fprefix, err)
+		log.Errorf("could not render graph for %s: %v", fprefix, err)
 		return
 	}
 
@@ -94,19 +95,19 @@ func writeGraph(ctx context.Context, input ExpansionInput, working, result const
 		_, err = io.Copy(f, strings.NewReader(content))
 	}
 	if err != nil {
-		zap.S().Errorf("could not write file %s: %v", f.Name(), err)
+		log.Errorf("could not write file %s: %v", f.Name(), err)
 		return
 	}
 
 	svgContent, err := dot.ExecPan(strings.NewReader(content))
 	if err != nil {
-		zap.S().Errorf("could not render graph to file %s: %v", fprefix, err)
+		log.Errorf("could not render graph to file %s: %v", fprefix, err)
 		return
 	}
 
 	svgFile, err := os.Create(fprefix + ".gv.svg")
 	if err != nil {
-		zap.S().Errorf("could not create file %s.gv.svg: %v", fprefix, err)
+		log.Errorf("could not create file %s.gv.svg: %v", fprefix, err)
 		return
 	}
 	defer svgFile.Close()
@@ -0,0 +1,224 @@
+package path_selection
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+)
+
+// pathConstraints returns the must_contain and must_not_contain edge constraints which apply to the expansion.
+// The constraints' source and target are resolved to the resources the engine created for them before matching,
+// since the satisfaction edge is between the (sanitized) resources rather than the IDs the constraint was given with.
+func pathConstraints(ctx solution.Solution, input ExpansionInput) ([]constraints.EdgeConstraint, error) {
+	var result []constraints.EdgeConstraint
+	for _, ec := range ctx.Constraints().Edges {
+		if ec.Operator != constraints.MustContainConstraintOperator &&
+			ec.Operator != constraints.MustNotContainConstraintOperator {
+			continue
+		}
+		target, err := ec.ResourceTarget(ctx.KnowledgeBase())
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve %s: %w", ec.String(), err)
+		}
+		if target.Source.Matches(input.SatisfactionEdge.Source.ID) &&
+			target.Target.Matches(input.SatisfactionEdge.Target.ID) {
+			result = append(result, ec)
+		}
+	}
+	return result, nil
+}
+
+type (
+	// constrainedState is a resource reached along with which of the constraints' nodes the path to it contains
+	// (bit i is set if it contains a resource of the type of constraint i's Node).
+	constrainedState struct {
+		id       construct.ResourceId
+		contains uint64
+	}
+
+	constrainedPath struct {
+		constrainedState
+		path   []construct.ResourceId
+		weight int
+	}
+
+	constrainedPathQueue []*constrainedPath
+)
+
+// constrainedShortestPath returns the cheapest path from source to target in the temp graph which satisfies all
+// the hard path constraints. Among those, paths satisfying more of the soft constraints are preferred over cheaper
+// paths which satisfy fewer, so soft constraints bias the selection without failing it when they cannot be met.
+//
+// Instead of listing every path (which grows exponentially with the graph), it is a shortest path search over the
+// resources paired with the set of constraint nodes contained so far: hard must_not_contain nodes are never entered,
+// and the cheapest path to the target is kept for each set, from which the best for the constraints is chosen.
+func constrainedShortestPath(
+	g construct.Graph,
+	source, target construct.ResourceId,
+	pathConstraints []constraints.EdgeConstraint,
+) ([]construct.ResourceId, error) {
+	if len(pathConstraints) > 64 {
+		return nil, fmt.Errorf("too many path constraints (%d) from %s to %s", len(pathConstraints), source, target)
+	}
+	adj, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+
+	contains := func(id construct.ResourceId) (mask uint64) {
+		for i, pc := range pathConstraints {
+			if pc.PathContainsNode([]construct.ResourceId{id}) {
+				mask |= 1 << i
+			}
+		}
+		return mask
+	}
+	// forbidden returns the hard must_not_contain constraint the resource would violate, if any
+	forbidden := func(id construct.ResourceId) *constraints.EdgeConstraint {
+		for i := range pathConstraints {
+			pc := &pathConstraints[i]
+			if pc.Operator == constraints.MustNotContainConstraintOperator && !pc.IsSoft() &&
+				pc.PathContainsNode([]construct.ResourceId{id}) {
+				return pc
+			}
+		}
+		return nil
+	}
+	noPath := engine_errs.NoPathError{Source: source, Target: target}
+	for _, id := range []construct.ResourceId{source, target} {
+		if pc := forbidden(id); pc != nil {
+			noPath.Constraint = pc
+			return nil, noPath
+		}
+	}
+
+	start := &constrainedPath{
+		constrainedState: constrainedState{id: source, contains: contains(source)},
+		path:             []construct.ResourceId{source},
+	}
+	best := map[constrainedState]*constrainedPath{start.constrainedState: start}
+	done := make(map[constrainedState]bool)
+	queue := constrainedPathQueue{start}
+	var reached []*constrainedPath
+	var blocked *constraints.EdgeConstraint
+	for queue.Len() > 0 {
+		current := heap.Pop(&queue).(*constrainedPath)
+		if done[current.constrainedState] {
+			continue
+		}
+		done[current.constrainedState] = true
+		if current.id == target {
+			reached = append(reached, current)
+			continue
+		}
+		for next, e := range adj[current.id] {
+			if pc := forbidden(next); pc != nil {
+				blocked = pc
+				continue
+			}
+			p := &constrainedPath{
+				constrainedState: constrainedState{id: next, contains: current.contains | contains(next)},
+				path:             append(slices.Clone(current.path), next),
+				weight:           current.weight + e.Properties.Weight,
+			}
+			if done[p.constrainedState] {
+				continue
+			}
+			if prev, ok := best[p.constrainedState]; ok && !p.less(prev) {
+				continue
+			}
+			best[p.constrainedState] = p
+			heap.Push(&queue, p)
+		}
+	}
+	if len(reached) == 0 {
+		if blocked != nil {
+			noPath.Constraint = blocked
+		}
+		return nil, noPath
+	}
+
+	var chosen *constrainedPath
+	chosenSoft := -1
+	for _, p := range reached {
+		soft, ok := p.satisfies(pathConstraints)
+		if !ok {
+			continue
+		}
+		if soft > chosenSoft || (soft == chosenSoft && p.less(chosen)) {
+			chosen, chosenSoft = p, soft
+		}
+	}
+	if chosen == nil {
+		// report the first hard constraint the cheapest path to the target fails
+		cheapest := slices.MinFunc(reached, func(a, b *constrainedPath) int {
+			switch {
+			case a.less(b):
+				return -1
+			case b.less(a):
+				return 1
+			}
+			return 0
+		})
+		for i, pc := range pathConstraints {
+			if !pc.IsSoft() && pc.Operator == constraints.MustContainConstraintOperator && cheapest.contains&(1<<i) == 0 {
+				noPath.Constraint = &pathConstraints[i]
+				break
+			}
+		}
+		return nil, noPath
+	}
+	return chosen.path, nil
+}
+
+// satisfies returns the number of soft constraints the path satisfies, and whether it satisfies all the hard ones.
+func (p *constrainedPath) satisfies(pathConstraints []constraints.EdgeConstraint) (soft int, ok bool) {
+	for i, pc := range pathConstraints {
+		contains := p.contains&(1<<i) != 0
+		satisfied := contains == (pc.Operator == constraints.MustContainConstraintOperator)
+		switch {
+		case satisfied && pc.IsSoft():
+			soft++
+		case !satisfied && !pc.IsSoft():
+			return soft, false
+		}
+	}
+	return soft, true
+}
+
+// less orders paths by weight, then length, then their resource IDs so the selection is stable.
+func (p *constrainedPath) less(other *constrainedPath) bool {
+	if p.weight != other.weight {
+		return p.weight < other.weight
+	}
+	if len(p.path) != len(other.path) {
+		return len(p.path) < len(other.path)
+	}
+	for k := range p.path {
+		if p.path[k] != other.path[k] {
+			return construct.ResourceIdLess(p.path[k], other.path[k])
+		}
+	}
+	return false
+}
+
+func (q constrainedPathQueue) Len() int           { return len(q) }
+func (q constrainedPathQueue) Less(i, j int) bool { return q[i].less(q[j]) }
+func (q constrainedPathQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *constrainedPathQueue) Push(x any) {
+	*q = append(*q, x.(*constrainedPath))
+}
+
+func (q *constrainedPathQueue) Pop() any {
+	old := *q
+	n := len(old)
+	p := old[n-1]
+	*q = old[:n-1]
+	return p
+}
@@ -0,0 +1,167 @@
+package path_selection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/enginetesting"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_constrainedShortestPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints []constraints.EdgeConstraint
+		want        string
+		wantErr     bool
+	}{
+		{
+			name: "no constraints picks the cheapest path",
+			want: "p:compute:a -> p:glue:b -> p:compute:d",
+		},
+		{
+			name: "soft must contain biases the path",
+			constraints: []constraints.EdgeConstraint{
+				{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:proxy:x"), Strength: constraints.SoftConstraintStrength},
+			},
+			want: "p:compute:a -> p:proxy:c -> p:compute:d",
+		},
+		{
+			name: "unsatisfiable soft must contain does not fail",
+			constraints: []constraints.EdgeConstraint{
+				{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:queue:x"), Strength: constraints.SoftConstraintStrength},
+			},
+			want: "p:compute:a -> p:glue:b -> p:compute:d",
+		},
+		{
+			name: "hard must contain",
+			constraints: []constraints.EdgeConstraint{
+				{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:proxy:x")},
+			},
+			want: "p:compute:a -> p:proxy:c -> p:compute:d",
+		},
+		{
+			name: "unsatisfiable hard must contain fails",
+			constraints: []constraints.EdgeConstraint{
+				{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:queue:x")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "soft must not contain",
+			constraints: []constraints.EdgeConstraint{
+				{Operator: constraints.MustNotContainConstraintOperator, Node: graphtest.ParseId(t, "p:glue:x"), Strength: constraints.SoftConstraintStrength},
+			},
+			want: "p:compute:a -> p:proxy:c -> p:compute:d",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			a, b := graphtest.ParseId(t, "p:compute:a"), graphtest.ParseId(t, "p:glue:b")
+			c, d := graphtest.ParseId(t, "p:proxy:c"), graphtest.ParseId(t, "p:compute:d")
+			g := graphtest.MakeGraph(t, construct.NewAcyclicGraph(graph.Weighted()), a, b, c, d)
+			require.NoError(g.AddEdge(a, b, graph.EdgeWeight(1)))
+			require.NoError(g.AddEdge(b, d, graph.EdgeWeight(1)))
+			require.NoError(g.AddEdge(a, c, graph.EdgeWeight(10)))
+			require.NoError(g.AddEdge(c, d, graph.EdgeWeight(10)))
+
+			path, err := constrainedShortestPath(g, a, d, tt.constraints)
+			if tt.wantErr {
+				var noPath engine_errs.NoPathError
+				require.ErrorAs(err, &noPath)
+				assert.Equal(a, noPath.Source)
+				assert.Equal(d, noPath.Target)
+				assert.Equal(&tt.constraints[0], noPath.Constraint)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, construct.Path(path).String())
+		})
+	}
+}
+
+func Test_constrainedShortestPath_ManyPaths(t *testing.T) {
+	// 40 diamonds in a row have 2^40 paths between the ends, which is too many to list
+	const layers = 40
+	g := construct.NewAcyclicGraph(graph.Weighted())
+	prev := graphtest.ParseId(t, "p:compute:start")
+	require.NoError(t, g.AddVertex(&construct.Resource{ID: prev}))
+	for i := 0; i < layers; i++ {
+		cheap := graphtest.ParseId(t, fmt.Sprintf("p:glue:cheap%d", i))
+		expensive := graphtest.ParseId(t, fmt.Sprintf("p:glue:expensive%d", i))
+		if i == layers/2 {
+			expensive.Type = "proxy"
+		}
+		join := graphtest.ParseId(t, fmt.Sprintf("p:compute:join%d", i))
+		for _, id := range []construct.ResourceId{cheap, expensive, join} {
+			require.NoError(t, g.AddVertex(&construct.Resource{ID: id}))
+		}
+		require.NoError(t, g.AddEdge(prev, cheap, graph.EdgeWeight(1)))
+		require.NoError(t, g.AddEdge(cheap, join, graph.EdgeWeight(1)))
+		require.NoError(t, g.AddEdge(prev, expensive, graph.EdgeWeight(5)))
+		require.NoError(t, g.AddEdge(expensive, join, graph.EdgeWeight(5)))
+		prev = join
+	}
+	start, end := graphtest.ParseId(t, "p:compute:start"), prev
+
+	path, err := constrainedShortestPath(g, start, end, []constraints.EdgeConstraint{
+		{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:proxy:x")},
+	})
+	require.NoError(t, err)
+	assert.Len(t, path, 2*layers+1)
+	assert.Contains(t, path, graphtest.ParseId(t, fmt.Sprintf("p:proxy:expensive%d", layers/2)))
+	assert.Contains(t, path, graphtest.ParseId(t, "p:glue:cheap0"))
+
+	_, err = constrainedShortestPath(g, start, end, []constraints.EdgeConstraint{
+		{Operator: constraints.MustContainConstraintOperator, Node: graphtest.ParseId(t, "p:proxy:x")},
+		{Operator: constraints.MustNotContainConstraintOperator, Node: graphtest.ParseId(t, "p:proxy:x")},
+	})
+	var noPath engine_errs.NoPathError
+	require.ErrorAs(t, err, &noPath)
+}
+
+func Test_pathConstraints(t *testing.T) {
+	sanitize, err := knowledgebase.NewSanitizationTmpl("p:compute", `{{ . | replace "_" "-" }}`)
+	require.NoError(t, err)
+
+	sol := enginetesting.NewTestSolution()
+	sol.KB.On("GetResourceTemplate", mock.Anything).Return(&knowledgebase.ResourceTemplate{SanitizeNameTmpl: sanitize}, nil)
+	proxy := constraints.EdgeConstraint{
+		Operator: constraints.MustContainConstraintOperator,
+		Target: constraints.Edge{
+			Source: graphtest.ParseId(t, "p:compute:my_api"),
+			Target: graphtest.ParseId(t, "p:compute:my_db"),
+		},
+		Node: graphtest.ParseId(t, "p:proxy:x"),
+	}
+	global := constraints.EdgeConstraint{
+		Operator: constraints.MustNotContainConstraintOperator,
+		Target: constraints.Edge{
+			Source: graphtest.ParseId(t, "p:compute"),
+			Target: graphtest.ParseId(t, "p:compute"),
+		},
+		Node: graphtest.ParseId(t, "p:glue:x"),
+	}
+	other := proxy
+	other.Target.Target = graphtest.ParseId(t, "p:compute:other")
+	sol.Constr.Edges = []constraints.EdgeConstraint{proxy, global, other}
+
+	got, err := pathConstraints(sol, ExpansionInput{
+		SatisfactionEdge: construct.ResourceEdge{
+			Source: &construct.Resource{ID: graphtest.ParseId(t, "p:compute:my-api")},
+			Target: &construct.Resource{ID: graphtest.ParseId(t, "p:compute:my-db")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []constraints.EdgeConstraint{proxy, global}, got)
+}
@@ -116,12 +116,29 @@ func expandEdge(
 		return nil, errs
 	}
 
-	path, err := graph.ShortestPathStable(
-		input.TempGraph,
-		input.SatisfactionEdge.Source.ID,
-		input.SatisfactionEdge.Target.ID,
-		construct.ResourceIdLess,
-	)
+	var path []construct.ResourceId
+	pcs, err := pathConstraints(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(pcs) > 0 {
+		path, err = constrainedShortestPath(
+			input.TempGraph,
+			input.SatisfactionEdge.Source.ID,
+			input.SatisfactionEdge.Target.ID,
+			pcs,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not expand %s: %w", input.ExpandEdge, err)
+		}
+	} else {
+		path, err = graph.ShortestPathStable(
+			input.TempGraph,
+			input.SatisfactionEdge.Source.ID,
+			input.SatisfactionEdge.Target.ID,
+			construct.ResourceIdLess,
+		)
+	}
 	if err != nil {
 		// NOTE(gg) this can't happen with the current expandPath implementation
 		// but may in the future.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/dominikbraun/graph"
 	"github.com/klothoplatform/klotho/pkg/collectionutil"
@@ -113,16 +114,61 @@ func BuildPathSelectionGraph(
 		return nil
 	}
 
-	err = ClassPaths(kbGraph, dep.Source.QualifiedTypeName(), dep.Target.QualifiedTypeName(), classification, addPath)
+	paths, err := cachedClassPaths(kb, kbGraph, dep.Source.QualifiedTypeName(), dep.Target.QualifiedTypeName(), classification)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find paths for %s: %w", dep, err)
 	}
+	var errs []error
+	for _, path := range paths {
+		if err := addPath(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("failed to find paths for %s: %w", dep, err)
+	}
 
 	log.Debugf("Found %d paths for %s :: %s", satisfied_paths, dep, classification)
 
 	return tempGraph, nil
 }
 
+// cachedClassPaths returns the [ClassPaths] between the types, cached by the knowledge base if it supports it. Path
+// selection runs for every edge, and edges between the same types and classification always find the same paths.
+func cachedClassPaths(
+	kb knowledgebase.TemplateKB,
+	kbGraph knowledgebase.Graph,
+	start, end string,
+	classification string,
+) ([][]string, error) {
+	find := func() ([][]string, error) {
+		var paths [][]string
+		addPath := func(path []string) error {
+			// ClassPaths reuses the path's backing array while searching
+			paths = append(paths, slices.Clone(path))
+			return nil
+		}
+		// Once the knowledge base is split by provider, look up each type's edges in its provider's partition
+		var err error
+		if edges, ok := kb.(interface {
+			IsSplitByProvider() bool
+			GetEdgesWithSource(construct.ResourceId) ([]*knowledgebase.EdgeTemplate, error)
+		}); ok && edges.IsSplitByProvider() {
+			err = ClassPathsFrom(kbGraph, edges.GetEdgesWithSource, start, end, classification, addPath)
+		} else {
+			err = ClassPaths(kbGraph, start, end, classification, addPath)
+		}
+		return paths, err
+	}
+	cache, ok := kb.(interface {
+		CachedPaths(knowledgebase.PathCacheKey, func() ([][]string, error)) ([][]string, error)
+	})
+	if !ok {
+		return find()
+	}
+	return cache.CachedPaths(knowledgebase.PathCacheKey{From: start, To: end, Classification: classification}, find)
+}
+
 func makePhantom(g construct.Graph, id construct.ResourceId) (construct.ResourceId, error) {
 	for suffix := 0; suffix < 1000; suffix++ {
 		candidate := id
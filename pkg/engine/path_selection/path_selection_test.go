@@ -9,6 +9,7 @@ import (
 	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
 	"github.com/klothoplatform/klotho/pkg/knowledgebase/kbtesting"
+	"github.com/klothoplatform/klotho/pkg/templates"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,7 @@ func TestBuildPathSelectionGraph(t *testing.T) {
 		dep            string
 		kb             *knowledgebase.KnowledgeBase
 		classification string
+		splitKB        bool
 	}
 	tests := []struct {
 		name        string
@@ -56,10 +58,30 @@ func TestBuildPathSelectionGraph(t *testing.T) {
 				"p:b:phantom$0 -> p:c:c": 109,
 			},
 		},
+		{
+			name: "path across providers in a split knowledge base",
+			args: args{
+				dep: "p:a:a -> p:c:c",
+				kb: kbtesting.MakeKB(t,
+					&knowledgebase.ResourceTemplate{QualifiedTypeName: "q:b", Classification: class("network")},
+					"p:a -> q:b -> p:c",
+				),
+				classification: "network",
+				splitKB:        true,
+			},
+			want: []any{"p:a:a -> q:b:phantom$0 -> p:c:c"},
+			wantWeights: map[string]int{
+				"p:a:a -> q:b:phantom$0": 109,
+				"q:b:phantom$0 -> p:c:c": 109,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			dep := graphtest.ParseEdge(t, tt.args.dep)
+			if tt.args.splitKB {
+				require.NoError(t, tt.args.kb.SplitByProvider())
+			}
 
 			got, err := BuildPathSelectionGraph(
 				context.Background(),
@@ -87,3 +109,31 @@ func TestBuildPathSelectionGraph(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkBuildPathSelectionGraph(b *testing.B) {
+	tkb, err := templates.NewKBFromTemplates()
+	require.NoError(b, err)
+	kb := tkb.(*knowledgebase.KnowledgeBase)
+	dep := construct.SimpleEdge{
+		Source: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+		Target: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+	}
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := BuildPathSelectionGraph(context.Background(), dep, kb, "", true); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			kb.ClearPathCache()
+			if _, err := BuildPathSelectionGraph(context.Background(), dep, kb, "", true); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
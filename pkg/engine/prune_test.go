@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/reconciler"
+	"github.com/klothoplatform/klotho/pkg/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_PruneUnreachable(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested string
+		initial   bool
+		want      string
+	}{
+		{
+			// target group names may not contain underscores, so the resource is created as 'my-tg'
+			name:      "sanitized name",
+			requested: "aws:target_group:my_tg",
+			want:      "aws:target_group:my-tg",
+		},
+		{
+			// resources in the initial state keep the name they are given
+			name:      "unsanitized initial state",
+			requested: "aws:target_group:my_tg",
+			initial:   true,
+			want:      "aws:target_group:my_tg",
+		},
+		{
+			name:      "namespaced while solving",
+			requested: "aws:subnet:s1",
+			want:      "aws:subnet:vpc-0:s1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main := EngineMain{}
+			require.NoError(t, main.AddEngine())
+
+			req := &SolveRequest{PruneUnreachable: true}
+			if tt.initial {
+				req.InitialState = graphtest.MakeGraph(t, construct.NewGraph(), tt.requested)
+			} else {
+				req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+					Operator: constraints.AddConstraintOperator,
+					Node:     graphtest.ParseId(t, tt.requested),
+				})
+			}
+			sol, err := main.Engine.Run(context.Background(), req)
+			require.NoError(t, err)
+
+			_, err = sol.DataflowGraph().Vertex(graphtest.ParseId(t, tt.want))
+			assert.NoError(t, err, "the requested resource must not be pruned")
+		})
+	}
+}
+
+func TestPruneUnreachable_SolvedGraph(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	// fn2 shares fn1's VPC (and through it the subnets, availability zones and region), but is not requested
+	req := &SolveRequest{}
+	for _, id := range []string{"aws:lambda_function:fn1", "aws:lambda_function:fn2", "aws:vpc:vpc"} {
+		req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+			Operator: constraints.AddConstraintOperator,
+			Node:     graphtest.ParseId(t, id),
+		})
+	}
+	for _, fn := range []string{"aws:lambda_function:fn1", "aws:lambda_function:fn2"} {
+		req.Constraints.Edges = append(req.Constraints.Edges, constraints.EdgeConstraint{
+			Operator: constraints.MustExistConstraintOperator,
+			Target:   constraints.Edge{Source: graphtest.ParseId(t, fn), Target: graphtest.ParseId(t, "aws:vpc:vpc")},
+		})
+	}
+	sol, err := main.Engine.Run(context.Background(), req)
+	require.NoError(t, err)
+
+	removed, err := reconciler.PruneUnreachable(sol, set.SetOf(graphtest.ParseId(t, "aws:lambda_function:fn1")))
+	require.NoError(t, err)
+
+	var gotRemoved []string
+	for _, id := range removed {
+		gotRemoved = append(gotRemoved, id.String())
+	}
+	assert.ElementsMatch(t, []string{
+		"aws:lambda_function:fn2",
+		"aws:ecr_image:fn2-image",
+		"aws:ecr_repo:fn2-image-ecr_repo",
+		"aws:iam_role:fn2-ExecutionRole",
+		"aws:log_group:fn2-log_group",
+		"aws:security_group:vpc:fn2-security_group",
+	}, gotRemoved)
+
+	for _, id := range []string{
+		"aws:lambda_function:fn1",
+		"aws:security_group:vpc:fn1-security_group",
+		"aws:vpc:vpc",
+		"aws:region:region-0",
+	} {
+		_, err := sol.DataflowGraph().Vertex(graphtest.ParseId(t, id))
+		assert.NoError(t, err, "%s should be kept", id)
+	}
+}
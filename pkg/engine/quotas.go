@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	"github.com/klothoplatform/klotho/pkg/logging"
+	"github.com/klothoplatform/klotho/pkg/provider/aws"
+)
+
+// checkQuotas checks the solution against the default AWS account quotas, with the given overrides. Violations are
+// added to the warnings in "warn" mode (so they only fail the run in strict mode) and fail the run in "error" mode.
+func checkQuotas(sol solution.Solution, mode string, overrides map[string]int, warnings *Warnings) error {
+	switch mode {
+	case "":
+		return nil
+	case "warn", "error":
+	default:
+		return engine_errs.InvalidConfigError{
+			Option: "quota check",
+			Err:    fmt.Errorf("%q must be warn or error", mode),
+		}
+	}
+	quotas := make(map[string]int, len(aws.DefaultQuotas)+len(overrides))
+	for qualifiedType, limit := range aws.DefaultQuotas {
+		quotas[qualifiedType] = limit
+	}
+	for qualifiedType, limit := range overrides {
+		quotas[qualifiedType] = limit
+	}
+	violations, err := aws.CheckQuotas(sol.DataflowGraph(), quotas)
+	if err != nil {
+		return fmt.Errorf("failed to check quotas: %w", err)
+	}
+	if mode == "error" && len(violations) > 0 {
+		msgs := make([]string, len(violations))
+		for i, v := range violations {
+			msgs[i] = v.String()
+		}
+		return errors.New("solution exceeds account quotas: " + strings.Join(msgs, "; "))
+	}
+	log := logging.GetLogger(sol.Context()).Named("engine").Sugar()
+	for _, v := range violations {
+		warnings.Add(log, "%s", v)
+	}
+	return nil
+}
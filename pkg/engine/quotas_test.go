@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_QuotaCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		strict  bool
+		wantErr string
+	}{
+		{
+			name: "warn",
+			mode: "warn",
+		},
+		{
+			name:    "warn in strict mode",
+			mode:    "warn",
+			strict:  true,
+			wantErr: "1 warning(s) treated as errors: 2 aws:s3_bucket resources exceed the account limit of 1",
+		},
+		{
+			name:    "error",
+			mode:    "error",
+			wantErr: "solution exceeds account quotas: 2 aws:s3_bucket resources exceed the account limit of 1",
+		},
+		{
+			name:    "invalid mode",
+			mode:    "fail",
+			wantErr: `invalid quota check: "fail" must be warn or error`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main := EngineMain{}
+			require.NoError(t, main.AddEngine())
+			main.Engine.Strict = tt.strict
+
+			req := &SolveRequest{
+				QuotaCheck:  tt.mode,
+				QuotaLimits: map[string]int{"aws:s3_bucket": 1},
+			}
+			for _, id := range []string{"aws:s3_bucket:a", "aws:s3_bucket:b"} {
+				req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+					Operator: constraints.AddConstraintOperator,
+					Node:     graphtest.ParseId(t, id),
+				})
+			}
+			_, err := main.Engine.Run(context.Background(), req)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+			if tt.mode == "fail" {
+				assert.ErrorAs(t, err, new(engine_errs.InvalidConfigError))
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package reconciler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// PruneUnreachable removes resources which are not reachable from any of the roots (see [reachableFrom]), through
+// either the dataflow or deployment graph. Resources are removed via [RemoveResource] so that each resource's delete
+// context is respected, and resources which require an explicit delete (such as global resources like the region)
+// are never pruned. It returns the resources that were removed.
+func PruneUnreachable(c solution.Solution, roots set.Set[construct.ResourceId]) ([]construct.ResourceId, error) {
+	reachable, err := reachableFrom(c.KnowledgeBase(), roots, c.DataflowGraph(), c.DeploymentGraph())
+	if err != nil {
+		return nil, err
+	}
+
+	adj, err := c.DataflowGraph().AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+	var candidates []construct.ResourceId
+	for id := range adj {
+		if !reachable.Contains(id) {
+			candidates = append(candidates, id)
+		}
+	}
+	// Remove the functional resources first, so that the resources which only support them can be removed after
+	functional := func(id construct.ResourceId) bool {
+		return knowledgebase.GetFunctionality(c.KnowledgeBase(), id) != knowledgebase.Unknown
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if fi, fj := functional(candidates[i]), functional(candidates[j]); fi != fj {
+			return fi
+		}
+		return construct.ResourceIdLess(candidates[i], candidates[j])
+	})
+
+	var errs error
+	for _, id := range candidates {
+		res, err := c.RawView().Vertex(id)
+		if errors.Is(err, graph.ErrVertexNotFound) {
+			// already removed as part of a previous resource's removal
+			continue
+		} else if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		rt, err := c.KnowledgeBase().GetResourceTemplate(id)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not get template for %s: %w", id, err))
+			continue
+		}
+		if rt == nil || rt.DeleteContext.RequiresExplicitDelete {
+			continue
+		}
+		// Functional resources are never removed implicitly, but unreachable ones were not requested, so they
+		// are removed explicitly. Their own dependencies are then only removed if their delete context allows.
+		explicit := rt.GetFunctionality() != knowledgebase.Unknown && !res.Imported
+		errs = errors.Join(errs, RemoveResource(c, id, explicit))
+	}
+
+	var removed []construct.ResourceId
+	for _, id := range candidates {
+		_, err := c.RawView().Vertex(id)
+		if errors.Is(err, graph.ErrVertexNotFound) {
+			removed = append(removed, id)
+		}
+	}
+	return removed, errs
+}
+
+// reachableFrom returns the resources the roots depend on, along with the supporting resources attached to them.
+//
+// Edges are only followed in the dependency direction (source to target) from the roots, since shared resources such
+// as the region or a VPC are depended on by every resource using them. Going upstream, only resources without a
+// functionality (such as a security group or route table) are kept, and only when every functional resource they
+// directly lead to is kept, so that a shared resource does not keep the resources of an unreachable function.
+func reachableFrom(
+	kb knowledgebase.TemplateKB,
+	roots set.Set[construct.ResourceId],
+	graphs ...construct.Graph,
+) (set.Set[construct.ResourceId], error) {
+	downstream := make(map[construct.ResourceId]set.Set[construct.ResourceId])
+	upstream := make(map[construct.ResourceId]set.Set[construct.ResourceId])
+	addEdge := func(m map[construct.ResourceId]set.Set[construct.ResourceId], a, b construct.ResourceId) {
+		if m[a] == nil {
+			m[a] = make(set.Set[construct.ResourceId])
+		}
+		m[a].Add(b)
+	}
+	for _, g := range graphs {
+		adj, err := g.AdjacencyMap()
+		if err != nil {
+			return nil, err
+		}
+		for src, targets := range adj {
+			for tgt := range targets {
+				addEdge(downstream, src, tgt)
+				addEdge(upstream, tgt, src)
+			}
+		}
+	}
+	functional := func(id construct.ResourceId) bool {
+		return knowledgebase.GetFunctionality(kb, id) != knowledgebase.Unknown
+	}
+
+	reachable := make(set.Set[construct.ResourceId])
+	var queue []construct.ResourceId
+	add := func(id construct.ResourceId) {
+		if !reachable.Contains(id) {
+			reachable.Add(id)
+			queue = append(queue, id)
+		}
+	}
+	for _, id := range roots.ToSlice() {
+		add(id)
+	}
+	// supporting returns whether u is a supporting resource of the reachable resources
+	supporting := func(u construct.ResourceId) bool {
+		if functional(u) {
+			return false
+		}
+		for d := range downstream[u] {
+			if functional(d) && !reachable.Contains(d) {
+				return false
+			}
+		}
+		return true
+	}
+	for len(queue) > 0 {
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for d := range downstream[id] {
+				add(d)
+			}
+		}
+		// Supporting resources are checked once all the dependencies are known, since whether a resource is
+		// supporting depends on which functional resources are reachable.
+		for _, id := range reachable.ToSlice() {
+			for u := range upstream[id] {
+				if !reachable.Contains(u) && supporting(u) {
+					add(u)
+				}
+			}
+		}
+	}
+	return reachable, nil
+}
@@ -0,0 +1,88 @@
+package reconciler
+
+import (
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/enginetesting"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneUnreachable(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []any
+		roots       []string
+		explicitDel []string
+		want        enginetesting.ExpectedGraphs
+		wantRemoved []string
+	}{
+		{
+			name:    "nothing unreachable",
+			initial: []any{"p:a:a -> p:b:b"},
+			roots:   []string{"p:a:a"},
+			want: enginetesting.ExpectedGraphs{
+				Dataflow: []any{"p:a:a -> p:b:b"},
+			},
+		},
+		{
+			name:    "unreachable resources are pruned",
+			initial: []any{"p:a:a -> p:b:b", "p:c:c -> p:d:d"},
+			roots:   []string{"p:a:a"},
+			want: enginetesting.ExpectedGraphs{
+				Dataflow: []any{"p:a:a -> p:b:b"},
+			},
+			wantRemoved: []string{"p:c:c", "p:d:d"},
+		},
+		{
+			name:    "reachable through upstream",
+			initial: []any{"p:a:a -> p:b:b", "p:c:c -> p:b:b"},
+			roots:   []string{"p:a:a"},
+			want: enginetesting.ExpectedGraphs{
+				Dataflow: []any{"p:a:a -> p:b:b", "p:c:c -> p:b:b"},
+			},
+		},
+		{
+			name:        "resources requiring explicit delete are kept",
+			initial:     []any{"p:a:a", "p:region:region"},
+			roots:       []string{"p:a:a"},
+			explicitDel: []string{"p:region:region"},
+			want: enginetesting.ExpectedGraphs{
+				Dataflow: []any{"p:a:a", "p:region:region"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			sol := enginetesting.NewTestSolution()
+			for _, id := range tt.explicitDel {
+				sol.KB.On("GetResourceTemplate", graphtest.ParseId(t, id)).Return(&knowledgebase.ResourceTemplate{
+					DeleteContext: knowledgebase.DeleteContext{RequiresExplicitDelete: true},
+				}, nil)
+			}
+			sol.UseEmptyTemplates()
+			sol.LoadState(t, tt.initial...)
+
+			roots := make(set.Set[construct.ResourceId])
+			for _, id := range tt.roots {
+				roots.Add(graphtest.ParseId(t, id))
+			}
+
+			removed, err := PruneUnreachable(sol, roots)
+			require.NoError(err)
+
+			var wantRemoved []construct.ResourceId
+			for _, id := range tt.wantRemoved {
+				wantRemoved = append(wantRemoved, graphtest.ParseId(t, id))
+			}
+			assert.Equal(wantRemoved, removed)
+			tt.want.AssertEqual(t, sol)
+		})
+	}
+}
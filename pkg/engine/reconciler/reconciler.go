@@ -9,6 +9,7 @@ import (
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
 	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"github.com/klothoplatform/klotho/pkg/set"
 	"go.uber.org/zap"
 )
@@ -21,7 +22,7 @@ type (
 )
 
 func RemoveResource(c solution.Solution, resource construct.ResourceId, explicit bool) error {
-	zap.S().Debugf("reconciling removal of resource %s ", resource)
+	logging.GetLogger(c.Context()).Sugar().Debugf("reconciling removal of resource %s ", resource)
 
 	queue := []deleteRequest{{
 		resource: resource,
@@ -249,7 +250,7 @@ func canDeleteResource(
 		return false, nil
 	}
 
-	log := zap.S().With(zap.String("id", resource.String()))
+	log := logging.GetLogger(ctx.Context()).Sugar().With(zap.String("id", resource.String()))
 	deletionCriteria := template.DeleteContext
 
 	ignoreUpstream := ignoreCriteria(ctx, resource, upstreamNodes, knowledgebase.DirectionUpstream)
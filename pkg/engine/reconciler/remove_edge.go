@@ -8,8 +8,8 @@ import (
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/path_selection"
 	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	"github.com/klothoplatform/klotho/pkg/logging"
 	"github.com/klothoplatform/klotho/pkg/set"
-	"go.uber.org/zap"
 )
 
 // RemovePath removes all paths between the source and target node.
@@ -19,7 +19,7 @@ func RemovePath(
 	source, target construct.ResourceId,
 	ctx solution.Solution,
 ) error {
-	zap.S().Infof("Removing path %s -> %s", source, target)
+	logging.GetLogger(ctx.Context()).Sugar().Infof("Removing path %s -> %s", source, target)
 	paths, err := graph.AllPathsBetween(ctx.DataflowGraph(), source, target)
 	if err != nil {
 		return err
@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/debug"
+	"github.com/klothoplatform/klotho/pkg/provider/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_RouteFunctions(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	api := graphtest.ParseId(t, "aws:rest_api:api")
+	reports := graphtest.ParseId(t, "aws:api_integration:api:reports")
+	items := graphtest.ParseId(t, "aws:api_integration:api:items")
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	bucket := graphtest.ParseId(t, "aws:s3_bucket:bucket")
+	req := &SolveRequest{
+		InitialState: graphtest.MakeGraph(t, construct.NewGraph(),
+			&construct.Resource{ID: api, Properties: construct.Properties{}},
+			&construct.Resource{ID: reports, Properties: construct.Properties{"Route": "/reports", "RestApi": api}},
+			&construct.Resource{ID: items, Properties: construct.Properties{"Route": "/items", "RestApi": api}},
+			"aws:rest_api:api -> aws:api_integration:api:reports",
+			"aws:rest_api:api -> aws:api_integration:api:items",
+			fn,
+			bucket,
+		),
+		Constraints: constraints.Constraints{Edges: []constraints.EdgeConstraint{
+			{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: reports, Target: fn},
+			},
+			{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: items, Target: fn},
+			},
+			{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: fn, Target: bucket},
+			},
+		}},
+		RouteFunctions: []aws.RouteFunction{{Route: "/reports", Timeout: 900}},
+	}
+	sol, err := main.Engine.Run(debug.WithDebugDir(context.Background(), t.TempDir()), req)
+	require.NoError(t, err)
+
+	original, err := sol.DataflowGraph().Vertex(fn)
+	require.NoError(t, err)
+	split, err := sol.DataflowGraph().Vertex(graphtest.ParseId(t, "aws:lambda_function:fn-reports"))
+	require.NoError(t, err)
+	assert.Equal(t, 900, split.Properties["Timeout"])
+
+	// The execution role isn't checked: path selection shares a role between any functions with the same access.
+	require.NotNil(t, original.Properties["Image"])
+	assert.NotEqual(t, original.Properties["Image"], split.Properties["Image"], "the split function should get its own image")
+	_, err = sol.RawView().Edge(split.ID, graphtest.ParseId(t, "aws:log_group:fn-reports-log_group"))
+	assert.NoError(t, err, "the split function should get its own log group")
+	deps, err := construct.AllDownstreamDependencies(sol.RawView(), split.ID)
+	require.NoError(t, err)
+	assert.Contains(t, deps, bucket, "the split function keeps the original's access")
+	for integration, target := range map[construct.ResourceId]construct.ResourceId{reports: split.ID, items: fn} {
+		permitted, err := permittedFunctions(sol.RawView(), integration)
+		require.NoError(t, err)
+		assert.Equal(t, []construct.ResourceId{target}, permitted, "%s should invoke %s", integration, target)
+	}
+}
+
+// permittedFunctions returns the functions the integration is permitted to invoke.
+func permittedFunctions(g construct.Graph, integration construct.ResourceId) ([]construct.ResourceId, error) {
+	var functions []construct.ResourceId
+	permissions, err := construct.DirectDownstreamDependencies(g, integration)
+	if err != nil {
+		return nil, err
+	}
+	for _, permission := range permissions {
+		if permission.Type != "lambda_permission" {
+			continue
+		}
+		fns, err := construct.DirectDownstreamDependencies(g, permission)
+		if err != nil {
+			return nil, err
+		}
+		functions = append(functions, fns...)
+	}
+	return functions, nil
+}
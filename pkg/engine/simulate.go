@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// ExpansionSimulation is the result of simulating an edge's expansion: what the engine would do to the graph
+// if the edge were added, without the graph being modified.
+type ExpansionSimulation struct {
+	// Path is the dataflow path chosen from the edge's source to its target
+	Path construct.Path
+	// Resources are the resources which would be added to the graph, in topological order
+	Resources []construct.ResourceId
+	// Edges are the edges which would be added to the graph
+	Edges []construct.SimpleEdge
+}
+
+// SimulateExpansion solves the graph with an additional edge from source to target and reports the path chosen for
+// it along with the resources and edges that solving would add. The engine runs against a copy of g, so g is never
+// modified. Source and target are added to the copy if they are not already in g.
+func (e *Engine) SimulateExpansion(
+	ctx context.Context,
+	g construct.Graph,
+	source, target construct.ResourceId,
+) (*ExpansionSimulation, error) {
+	if g == nil {
+		g = construct.NewGraph()
+	}
+	initial, err := cloneGraph(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not copy graph: %w", err)
+	}
+	for _, id := range []construct.ResourceId{source, target} {
+		err := initial.AddVertex(&construct.Resource{ID: id, Properties: make(construct.Properties)})
+		if err != nil && !errors.Is(err, graph.ErrVertexAlreadyExists) {
+			return nil, err
+		}
+	}
+
+	sol, err := e.Run(ctx, &SolveRequest{
+		InitialState: initial,
+		Constraints: constraints.Constraints{
+			Edges: []constraints.EdgeConstraint{{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: source, Target: target},
+			}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not expand %s -> %s: %w", source, target, err)
+	}
+
+	paths, err := construct.ShortestPaths(sol.DataflowGraph(), source, construct.DontSkipEdges)
+	if err != nil {
+		return nil, err
+	}
+	path, err := paths.ShortestPath(target)
+	if err != nil {
+		return nil, fmt.Errorf("could not find path for %s -> %s: %w", source, target, err)
+	}
+
+	sim := &ExpansionSimulation{Path: path}
+
+	existing, err := vertexIds(g)
+	if err != nil {
+		return nil, err
+	}
+	solved, err := construct.TopologicalSort(sol.RawView())
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range solved {
+		if !existing.Contains(id) {
+			sim.Resources = append(sim.Resources, id)
+		}
+	}
+
+	edges, err := sol.RawView().Edges()
+	if err != nil {
+		return nil, err
+	}
+	for _, edge := range edges {
+		if _, err := g.Edge(edge.Source, edge.Target); errors.Is(err, graph.ErrEdgeNotFound) {
+			sim.Edges = append(sim.Edges, construct.ToSimpleEdge(edge))
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return sim, nil
+}
+
+func vertexIds(g construct.Graph) (set.Set[construct.ResourceId], error) {
+	ids := make(set.Set[construct.ResourceId])
+	err := construct.WalkGraph(g, func(id construct.ResourceId, _ *construct.Resource, nerr error) error {
+		ids.Add(id)
+		return nerr
+	})
+	return ids, err
+}
+
+// cloneGraph copies g, including the resources' properties, so that solving the copy cannot modify g's resources.
+func cloneGraph(g construct.Graph) (construct.Graph, error) {
+	clone := construct.NewGraph()
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		return errors.Join(nerr, clone.AddVertex(&construct.Resource{
+			ID:         id,
+			Properties: resource.Properties.Clone(),
+			Imported:   resource.Imported,
+		}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, err
+	}
+	for _, edge := range edges {
+		err = errors.Join(err, clone.AddEdge(
+			edge.Source,
+			edge.Target,
+			graph.EdgeData(edge.Properties.Data),
+			graph.EdgeWeight(edge.Properties.Weight),
+		))
+	}
+	return clone, err
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/debug"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateExpansion(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	db := graphtest.ParseId(t, "aws:rds_instance:db")
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: fn, Properties: construct.Properties{"MemorySize": 512}},
+		db,
+	)
+	// keep the path selection debug output out of the package directory
+	ctx := debug.WithDebugDir(context.Background(), t.TempDir())
+
+	before, err := construct.String(g)
+	require.NoError(err)
+
+	sim, err := NewEngine(kb).SimulateExpansion(ctx, g, fn, db)
+	require.NoError(err)
+
+	assert.Equal("aws:lambda_function:fn -> aws:iam_role:fn-ExecutionRole -> aws:rds_instance:db", sim.Path.String())
+
+	added := make(map[string]int)
+	for _, id := range sim.Resources {
+		added[id.QualifiedTypeName()]++
+	}
+	assert.GreaterOrEqual(added["aws:subnet"], 2, "the function must be placed in the database's subnets")
+	assert.Equal(1, added["aws:rds_subnet_group"])
+	assert.Equal(1, added["aws:iam_role"])
+	assert.NotContains(sim.Resources, fn)
+	assert.Contains(sim.Edges, construct.SimpleEdge{Source: fn, Target: graphtest.ParseId(t, "aws:iam_role:fn-ExecutionRole")})
+
+	after, err := construct.String(g)
+	require.NoError(err)
+	assert.Equal(before, after, "the input graph must not be modified")
+	res, err := g.Vertex(fn)
+	require.NoError(err)
+	assert.Equal(construct.Properties{"MemorySize": 512}, res.Properties)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dominikbraun/graph"
 	"github.com/klothoplatform/klotho/pkg/logging"
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
@@ -85,14 +86,14 @@ func (s *engineSolution) Constraints() *constraints.Constraints {
 	return s.constraints
 }
 
-func (s *engineSolution) LoadGraph(graph construct.Graph) error {
-	if graph == nil {
+func (s *engineSolution) LoadGraph(input construct.Graph) error {
+	if input == nil {
 		return nil
 	}
-	// Since often the input `graph` is loaded from a yaml file, we need to transform all the property values
+	// Since often the input graph is loaded from a yaml file, we need to transform all the property values
 	// to make sure they are of the correct type (eg, a string to ResourceId).
 	err := knowledgebase.TransformAllPropertyValues(knowledgebase.DynamicValueContext{
-		Graph:         graph,
+		Graph:         input,
 		KnowledgeBase: s.KB,
 	})
 	if err != nil {
@@ -100,21 +101,21 @@ func (s *engineSolution) LoadGraph(graph construct.Graph) error {
 	}
 	op := s.OperationalView()
 	raw := s.RawView()
-	if err := op.AddVerticesFrom(graph); err != nil {
+	if err := op.AddVerticesFrom(input); err != nil {
 		return err
 	}
 
-	edges, err := graph.Edges()
+	edges, err := input.Edges()
 	if err != nil {
 		return err
 	}
 	for _, edge := range edges {
 		edgeTemplate := s.KB.GetEdgeTemplate(edge.Source, edge.Target)
-		src, err := graph.Vertex(edge.Source)
+		src, err := input.Vertex(edge.Source)
 		if err != nil {
 			return err
 		}
-		dst, err := graph.Vertex(edge.Target)
+		dst, err := input.Vertex(edge.Target)
 		if err != nil {
 			return err
 		}
@@ -125,7 +126,17 @@ func (s *engineSolution) LoadGraph(graph construct.Graph) error {
 			continue
 		}
 		if edgeTemplate == nil {
-			return fmt.Errorf("edge template %s -> %s not found", edge.Source, edge.Target)
+			data, _ := edge.Properties.Data.(construct.EdgeData)
+			if !data.OrderingOnly {
+				return fmt.Errorf("edge template %s -> %s not found", edge.Source, edge.Target)
+			}
+			// Ordering-only edges have no operational rules, so the only thing they contribute is deployment order.
+			err := s.Deployment.AddEdge(edge.Source, edge.Target, graph.EdgeData(data))
+			err = construct.EdgeCycleError(s.Deployment, edge.Source, edge.Target, err)
+			if err != nil && !errors.Is(err, graph.ErrEdgeAlreadyExists) {
+				return err
+			}
+			continue
 		}
 		if edgeTemplate.AlwaysProcess {
 			if err := op.AddEdge(edge.Source, edge.Target); err != nil {
@@ -112,7 +112,9 @@ func AddDeploymentDependenciesFromVal(
 		if resource.ID.Matches(id) {
 			continue
 		}
-		err := ctx.DeploymentGraph().AddEdge(resource.ID, id)
+		// The reference only orders the deployment, there is no dataflow edge (or edge template) to configure
+		err := ctx.DeploymentGraph().AddEdge(resource.ID, id, graph.EdgeData(construct.EdgeData{OrderingOnly: true}))
+		err = construct.EdgeCycleError(ctx.DeploymentGraph(), resource.ID, id, err)
 		if err != nil && !errors.Is(err, graph.ErrEdgeAlreadyExists) {
 			errs = errors.Join(errs, fmt.Errorf("failed to add deployment dependency from %s to %s: %w", resource.ID, id, err))
 		}
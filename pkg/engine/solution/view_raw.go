@@ -127,11 +127,12 @@ func (view RawAccessView) AddEdge(source, target construct.ResourceId, options .
 		return terr
 	}
 	if !srcRt.NoIac && !dstRt.NoIac && (et != nil && !et.NoIac) {
+		deplSource, deplTarget := source, target
 		if et != nil && et.DeploymentOrderReversed {
-			deplErr = view.inner.DeploymentGraph().AddEdge(target, source, options...)
-		} else {
-			deplErr = view.inner.DeploymentGraph().AddEdge(source, target, options...)
+			deplSource, deplTarget = target, source
 		}
+		deplErr = view.inner.DeploymentGraph().AddEdge(deplSource, deplTarget, options...)
+		deplErr = construct.EdgeCycleError(view.inner.DeploymentGraph(), deplSource, deplTarget, deplErr)
 		if errors.Is(dfErr, graph.ErrEdgeAlreadyExists) && errors.Is(deplErr, graph.ErrEdgeAlreadyExists) {
 			return graph.ErrEdgeAlreadyExists
 		}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/kbtesting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadGraph_EdgesWithoutTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    construct.EdgeData
+		wantErr bool
+	}{
+		{
+			name: "ordering-only edge",
+			data: construct.EdgeData{OrderingOnly: true},
+		},
+		{
+			name:    "missing edge template",
+			data:    construct.EdgeData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			kb := kbtesting.MakeKB(t, "p:a", "p:b")
+			sol := NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+
+			src, dst := graphtest.ParseId(t, "p:a:A"), graphtest.ParseId(t, "p:b:B")
+			g := graphtest.MakeGraph(t, construct.NewGraph(), src, dst)
+			require.NoError(g.AddEdge(src, dst, graph.EdgeData(tt.data)))
+
+			err := sol.LoadGraph(g)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			_, err = sol.DeploymentGraph().Edge(src, dst)
+			require.NoError(err, "ordering-only edge should be in the deployment graph")
+			_, err = sol.DataflowGraph().Edge(src, dst)
+			require.ErrorIs(err, graph.ErrEdgeNotFound, "ordering-only edge should not be in the dataflow graph")
+		})
+	}
+}
+
+func TestAddDeploymentDependenciesFromVal_OrderingOnly(t *testing.T) {
+	require := require.New(t)
+
+	kb := kbtesting.MakeKB(t, "p:a", "p:b")
+	sol := NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+
+	src, dst := graphtest.ParseId(t, "p:a:A"), graphtest.ParseId(t, "p:b:B")
+	res := &construct.Resource{ID: src, Properties: construct.Properties{"Ref": dst}}
+	require.NoError(sol.RawView().AddVertex(res))
+	require.NoError(sol.RawView().AddVertex(&construct.Resource{ID: dst, Properties: construct.Properties{}}))
+
+	require.NoError(solution.AddDeploymentDependenciesFromVal(sol, res, dst))
+
+	edge, err := sol.DeploymentGraph().Edge(src, dst)
+	require.NoError(err)
+	require.Equal(construct.EdgeData{OrderingOnly: true}, edge.Properties.Data)
+
+	// the ordering-only edge can be loaded back without an edge template
+	g := construct.NewGraph()
+	require.NoError(g.AddVerticesFrom(sol.DeploymentGraph()))
+	require.NoError(g.AddEdge(src, dst, graph.EdgeData(edge.Properties.Data)))
+	reloaded := NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+	require.NoError(reloaded.LoadGraph(g))
+}
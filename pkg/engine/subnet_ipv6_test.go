@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_SubnetIpv6(t *testing.T) {
+	vpc := graphtest.ParseId(t, "aws:vpc:vpc")
+	subnet := graphtest.ParseId(t, "aws:subnet:vpc:private")
+	tests := []struct {
+		name string
+		ipv6 bool
+	}{
+		{name: "vpc with ipv6", ipv6: true},
+		{name: "vpc without ipv6", ipv6: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			main := EngineMain{}
+			require.NoError(t, main.AddEngine())
+
+			req := &SolveRequest{GlobalTag: "app"}
+			for _, id := range []string{vpc.String(), subnet.String()} {
+				req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+					Operator: constraints.AddConstraintOperator,
+					Node:     graphtest.ParseId(t, id),
+				})
+			}
+			req.Constraints.Resources = append(req.Constraints.Resources, constraints.ResourceConstraint{
+				Operator: constraints.EqualsConstraintOperator,
+				Target:   vpc,
+				Property: "AssignGeneratedIpv6CidrBlock",
+				Value:    tt.ipv6,
+			})
+			sol, err := main.Engine.Run(context.Background(), req)
+			require.NoError(t, err)
+
+			res, err := sol.DataflowGraph().Vertex(subnet)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ipv6, res.Properties["AssignIpv6AddressOnCreation"])
+			// a private subnet in the first availability zone takes the /64 after the two public subnets'
+			assert.Equal(t, 2, res.Properties["Ipv6CidrBlockIndex"])
+		})
+	}
+}
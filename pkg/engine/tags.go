@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+)
+
+// applyTags adds the tags to every taggable resource in the solution. Tags already set on a resource take precedence,
+// so a resource can override a tag it would otherwise inherit. Imported resources are not modified since they're
+// owned elsewhere.
+func applyTags(sol solution.Solution, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	return construct.WalkGraph(sol.DataflowGraph(), func(id construct.ResourceId, res *construct.Resource, nerr error) error {
+		if res.Imported {
+			return nerr
+		}
+		rt, err := sol.KnowledgeBase().GetResourceTemplate(id)
+		if err != nil {
+			return errors.Join(nerr, err)
+		}
+		if rt == nil || rt.GetProperty("Tags") == nil {
+			return nerr
+		}
+		current, err := res.GetProperty("Tags")
+		if err != nil {
+			return errors.Join(nerr, fmt.Errorf("could not get tags of %s: %w", id, err))
+		}
+		merged := make(map[string]any, len(tags))
+		switch current := current.(type) {
+		case nil:
+		case map[string]any:
+			for k, v := range current {
+				merged[k] = v
+			}
+		case map[string]string:
+			for k, v := range current {
+				merged[k] = v
+			}
+		default:
+			return errors.Join(nerr, fmt.Errorf("tags of %s are not a map: %T", id, current))
+		}
+		for k, v := range tags {
+			if _, ok := merged[k]; !ok {
+				merged[k] = v
+			}
+		}
+		return errors.Join(nerr, res.SetProperty("Tags", merged))
+	})
+}
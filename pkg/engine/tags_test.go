@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_PropagatesTags(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	main := EngineMain{}
+	require.NoError(main.AddEngine())
+
+	db := construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"}
+	req := &SolveRequest{
+		Constraints: constraints.Constraints{
+			Application: []constraints.ApplicationConstraint{
+				{Operator: constraints.AddConstraintOperator, Node: db},
+			},
+		},
+		GlobalTag: "test",
+		Tags: map[string]string{
+			"team": "data",
+			// Tags set by the resource itself take precedence
+			"RESOURCE_NAME": "overridden",
+		},
+	}
+	sol, err := main.Engine.Run(context.Background(), req)
+	require.NoError(err)
+
+	tagged := map[string]bool{"rds_instance": false, "rds_subnet_group": false, "security_group": false}
+	err = construct.WalkGraph(sol.DataflowGraph(), func(id construct.ResourceId, res *construct.Resource, nerr error) error {
+		if _, ok := tagged[id.Type]; !ok {
+			return nerr
+		}
+		tagged[id.Type] = true
+		tags, err := res.GetProperty("Tags")
+		if !assert.NoError(err, id.String()) {
+			return nerr
+		}
+		assert.Equal(map[string]any{
+			"GLOBAL_KLOTHO_TAG": "test",
+			"RESOURCE_NAME":     id.Name,
+			"team":              "data",
+		}, tags, id.String())
+		return nerr
+	})
+	require.NoError(err)
+	for typ, found := range tagged {
+		assert.True(found, "expected a %s in the solution", typ)
+	}
+}
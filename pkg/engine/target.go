@@ -0,0 +1,231 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// ScopeToTarget limits the request to the target and everything it transitively depends on, so that a single part of
+// the application (such as one function) can be solved and deployed on its own. Dependencies are the edges of the
+// initial state, the resources referenced in properties (including resource constraint values) and the edge
+// constraints. Resources shared with the rest of the application, such as a VPC or cluster, are dependencies of the
+// target so they are kept. Constraints on resources outside of the scope are dropped, but type-only edge constraints
+// are kept.
+func (e *Engine) ScopeToTarget(req *SolveRequest, target construct.ResourceId) error {
+	deps, known, err := req.dependencies()
+	if err != nil {
+		return err
+	}
+	if !known.Contains(target) {
+		return fmt.Errorf("target %s is not in the input graph or constraints", target)
+	}
+
+	scope := set.SetOf(target)
+	queue := []construct.ResourceId{target}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for dep := range deps[id] {
+			if !scope.Contains(dep) {
+				scope.Add(dep)
+				queue = append(queue, dep)
+			}
+		}
+	}
+
+	if req.InitialState != nil {
+		req.InitialState, err = subgraph(req.InitialState, scope)
+		if err != nil {
+			return err
+		}
+	}
+	req.Constraints = scopeConstraints(req.Constraints, scope)
+	return nil
+}
+
+// dependencies returns the direct dependencies of each resource in the request, and all the resources the request
+// refers to.
+func (req SolveRequest) dependencies() (
+	map[construct.ResourceId]set.Set[construct.ResourceId],
+	set.Set[construct.ResourceId],
+	error,
+) {
+	deps := make(map[construct.ResourceId]set.Set[construct.ResourceId])
+	addDep := func(from, to construct.ResourceId) {
+		if from == to {
+			return
+		}
+		if deps[from] == nil {
+			deps[from] = make(set.Set[construct.ResourceId])
+		}
+		deps[from].Add(to)
+	}
+	known := make(set.Set[construct.ResourceId])
+
+	if req.InitialState != nil {
+		adj, err := req.InitialState.AdjacencyMap()
+		if err != nil {
+			return nil, nil, err
+		}
+		for source, targets := range adj {
+			known.Add(source)
+			for t := range targets {
+				addDep(source, t)
+			}
+		}
+	}
+	for _, c := range req.Constraints.Application {
+		known.Add(c.Node)
+		if !c.ReplacementNode.IsZero() {
+			known.Add(c.ReplacementNode)
+			addDep(c.Node, c.ReplacementNode)
+		}
+	}
+	for _, c := range req.Constraints.Edges {
+		if c.Operator == constraints.RemoveConstraintOperator || c.Operator == constraints.MustNotExistConstraintOperator {
+			continue
+		}
+		if c.Target.Source.Name == "" || c.Target.Target.Name == "" {
+			// type-only constraints apply to whichever resources of the type are in scope, so they are not dependencies
+			continue
+		}
+		known.Add(c.Target.Source, c.Target.Target)
+		addDep(c.Target.Source, c.Target.Target)
+	}
+
+	// References are only followed to resources which are known, so that strings which happen to parse as an ID
+	// (such as ARNs) are not mistaken for dependencies.
+	addRefs := func(from construct.ResourceId, v any) {
+		for _, ref := range referencedResources(v) {
+			if known.Contains(ref) {
+				addDep(from, ref)
+			}
+		}
+	}
+	if req.InitialState != nil {
+		err := construct.WalkGraph(req.InitialState, func(id construct.ResourceId, r *construct.Resource, nerr error) error {
+			return r.WalkProperties(func(path construct.PropertyPath, werr error) error {
+				v, _ := path.Get()
+				addRefs(id, v)
+				return werr
+			})
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, c := range req.Constraints.Resources {
+		addRefs(c.Target, c.Value)
+	}
+	return deps, known, nil
+}
+
+// referencedResources returns the resources referenced by the value, either directly or as a string (as values are
+// before they are loaded into the solution).
+func referencedResources(v any) []construct.ResourceId {
+	switch v := v.(type) {
+	case construct.ResourceId:
+		return []construct.ResourceId{v}
+
+	case construct.PropertyRef:
+		return []construct.ResourceId{v.Resource}
+
+	case string:
+		if strings.Contains(v, "#") {
+			var ref construct.PropertyRef
+			if ref.Parse(v) == nil {
+				return []construct.ResourceId{ref.Resource}
+			}
+			return nil
+		}
+		var id construct.ResourceId
+		if id.Parse(v) == nil && id.Validate() == nil {
+			return []construct.ResourceId{id}
+		}
+
+	case []any:
+		var ids []construct.ResourceId
+		for _, item := range v {
+			ids = append(ids, referencedResources(item)...)
+		}
+		return ids
+
+	case map[string]any:
+		var ids []construct.ResourceId
+		for _, item := range v {
+			ids = append(ids, referencedResources(item)...)
+		}
+		return ids
+	}
+	return nil
+}
+
+// subgraph returns a copy of g containing only the resources in scope and the edges between them.
+func subgraph(g construct.Graph, scope set.Set[construct.ResourceId]) (construct.Graph, error) {
+	sub := graph.NewLike(g)
+	err := construct.WalkGraph(g, func(id construct.ResourceId, r *construct.Resource, nerr error) error {
+		if scope.Contains(id) {
+			return sub.AddVertex(r)
+		}
+		return nerr
+	})
+	if err != nil {
+		return nil, err
+	}
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range edges {
+		if !scope.Contains(e.Source) || !scope.Contains(e.Target) {
+			continue
+		}
+		err := sub.AddEdge(e.Source, e.Target, func(ep *graph.EdgeProperties) {
+			*ep = e.Properties
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// scopeConstraints returns the constraints which only apply to resources in scope.
+func scopeConstraints(cs constraints.Constraints, scope set.Set[construct.ResourceId]) constraints.Constraints {
+	var scoped constraints.Constraints
+	for _, c := range cs.Application {
+		if scope.Contains(c.Node) {
+			scoped.Application = append(scoped.Application, c)
+		}
+	}
+	for _, c := range cs.Construct {
+		if scope.Contains(c.Target) {
+			scoped.Construct = append(scoped.Construct, c)
+		}
+	}
+	for _, c := range cs.Resources {
+		if scope.Contains(c.Target) {
+			scoped.Resources = append(scoped.Resources, c)
+		}
+	}
+	// a type-only side of an edge constraint (with no name) applies to every resource of the type, so it is in scope
+	inScope := func(id construct.ResourceId) bool {
+		return id.Name == "" || scope.Contains(id)
+	}
+	for _, c := range cs.Edges {
+		if inScope(c.Target.Source) && inScope(c.Target.Target) {
+			scoped.Edges = append(scoped.Edges, c)
+		}
+	}
+	for _, c := range cs.Outputs {
+		if scope.Contains(c.Ref.Resource) {
+			scoped.Outputs = append(scoped.Outputs, c)
+		}
+	}
+	return scoped
+}
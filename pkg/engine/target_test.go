@@ -0,0 +1,216 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	engine_errs "github.com/klothoplatform/klotho/pkg/engine/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeToTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		init        []any
+		constraints constraints.Constraints
+		target      string
+		wantIds     []string
+		wantEdges   []string
+		// wantAdded are the resources added by application constraints which remain in scope
+		wantAdded []string
+		// wantEdgeConstraints are the edge constraints which remain in scope, if set
+		wantEdgeConstraints []string
+		wantErr             string
+	}{
+		{
+			name: "keeps dependencies and shared resources",
+			init: []any{
+				"aws:lambda_function:api -> aws:dynamodb_table:table",
+				"aws:lambda_function:api -> aws:vpc:vpc",
+				"aws:lambda_function:worker -> aws:sqs_queue:queue",
+				"aws:lambda_function:worker -> aws:vpc:vpc",
+			},
+			constraints: constraints.Constraints{
+				Resources: []constraints.ResourceConstraint{
+					{Operator: constraints.EqualsConstraintOperator, Target: graphtest.ParseId(t, "aws:lambda_function:api"), Property: "MemorySize", Value: 512},
+					{Operator: constraints.EqualsConstraintOperator, Target: graphtest.ParseId(t, "aws:lambda_function:worker"), Property: "MemorySize", Value: 256},
+				},
+				Edges: []constraints.EdgeConstraint{
+					{
+						Operator: constraints.MustExistConstraintOperator,
+						Target: constraints.Edge{
+							Source: graphtest.ParseId(t, "aws:lambda_function:worker"),
+							Target: graphtest.ParseId(t, "aws:sqs_queue:queue"),
+						},
+					},
+				},
+			},
+			target:  "aws:lambda_function:api",
+			wantIds: []string{"aws:dynamodb_table:table", "aws:lambda_function:api", "aws:vpc:vpc"},
+			wantEdges: []string{
+				"aws:lambda_function:api -> aws:dynamodb_table:table",
+				"aws:lambda_function:api -> aws:vpc:vpc",
+			},
+		},
+		{
+			name: "keeps referenced resources",
+			init: []any{
+				&construct.Resource{
+					ID:         graphtest.ParseId(t, "aws:lambda_function:api"),
+					Properties: construct.Properties{"Role": graphtest.ParseId(t, "aws:iam_role:role")},
+				},
+				"aws:iam_role:role",
+				"aws:iam_role:other",
+			},
+			constraints: constraints.Constraints{
+				Resources: []constraints.ResourceConstraint{
+					{
+						Operator: constraints.EqualsConstraintOperator,
+						Target:   graphtest.ParseId(t, "aws:iam_role:role"),
+						Property: "ManagedPolicies",
+						Value:    []any{"aws:iam_policy:policy#Arn", "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+					},
+				},
+				Application: []constraints.ApplicationConstraint{
+					{Operator: constraints.AddConstraintOperator, Node: graphtest.ParseId(t, "aws:iam_policy:policy")},
+				},
+			},
+			target:    "aws:lambda_function:api",
+			wantIds:   []string{"aws:iam_role:role", "aws:lambda_function:api"},
+			wantAdded: []string{"aws:iam_policy:policy"},
+		},
+		{
+			name: "keeps type-only edge constraints",
+			init: []any{
+				"aws:lambda_function:api -> aws:dynamodb_table:table",
+				"aws:lambda_function:worker -> aws:sqs_queue:queue",
+			},
+			constraints: constraints.Constraints{
+				Edges: []constraints.EdgeConstraint{
+					{
+						Operator: constraints.MustNotContainConstraintOperator,
+						Target: constraints.Edge{
+							Source: construct.ResourceId{Provider: "aws", Type: "lambda_function"},
+							Target: construct.ResourceId{Provider: "aws", Type: "dynamodb_table"},
+						},
+						Node: construct.ResourceId{Provider: "aws", Type: "vpc_endpoint"},
+					},
+					{
+						Operator: constraints.MustExistConstraintOperator,
+						Target: constraints.Edge{
+							Source: construct.ResourceId{Provider: "aws", Type: "lambda_function"},
+							Target: graphtest.ParseId(t, "aws:dynamodb_table:table"),
+						},
+					},
+					{
+						Operator: constraints.MustExistConstraintOperator,
+						Target: constraints.Edge{
+							Source: construct.ResourceId{Provider: "aws", Type: "lambda_function"},
+							Target: graphtest.ParseId(t, "aws:sqs_queue:queue"),
+						},
+					},
+				},
+			},
+			target:  "aws:lambda_function:api",
+			wantIds: []string{"aws:dynamodb_table:table", "aws:lambda_function:api"},
+			wantEdges: []string{
+				"aws:lambda_function:api -> aws:dynamodb_table:table",
+			},
+			wantEdgeConstraints: []string{
+				"aws:lambda_function -> aws:dynamodb_table",
+				"aws:lambda_function -> aws:dynamodb_table:table",
+			},
+		},
+		{
+			name:    "unknown target",
+			init:    []any{"aws:lambda_function:api"},
+			target:  "aws:lambda_function:other",
+			wantErr: "target aws:lambda_function:other is not in the input graph or constraints",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			req := &SolveRequest{
+				InitialState: graphtest.MakeGraph(t, construct.NewGraph(), tt.init...),
+				Constraints:  tt.constraints,
+			}
+			err := (&Engine{}).ScopeToTarget(req, graphtest.ParseId(t, tt.target))
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+
+			ids, err := construct.TopologicalSort(req.InitialState)
+			require.NoError(err)
+			var gotIds []string
+			for _, id := range ids {
+				gotIds = append(gotIds, id.String())
+			}
+			assert.ElementsMatch(tt.wantIds, gotIds)
+
+			edges, err := req.InitialState.Edges()
+			require.NoError(err)
+			var gotEdges []string
+			for _, e := range edges {
+				gotEdges = append(gotEdges, construct.SimpleEdge{Source: e.Source, Target: e.Target}.String())
+			}
+			assert.ElementsMatch(tt.wantEdges, gotEdges)
+
+			var gotAdded []string
+			for _, c := range req.Constraints.Application {
+				gotAdded = append(gotAdded, c.Node.String())
+			}
+			assert.ElementsMatch(tt.wantAdded, gotAdded)
+
+			for _, c := range req.Constraints.Resources {
+				assert.Contains(tt.wantIds, c.Target.String())
+			}
+			var gotEdgeConstraints []string
+			for _, c := range req.Constraints.Edges {
+				gotEdgeConstraints = append(gotEdgeConstraints, construct.SimpleEdge{Source: c.Target.Source, Target: c.Target.Target}.String())
+				for _, id := range []construct.ResourceId{c.Target.Source, c.Target.Target} {
+					if id.Name != "" {
+						assert.Contains(tt.wantIds, id.String())
+					}
+				}
+			}
+			if tt.wantEdgeConstraints != nil {
+				assert.ElementsMatch(tt.wantEdgeConstraints, gotEdgeConstraints)
+			}
+		})
+	}
+}
+
+func TestRun_Target(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	newRequest := func(target string) *SolveRequest {
+		return &SolveRequest{
+			InitialState: graphtest.MakeGraph(t, construct.NewGraph(),
+				"aws:lambda_function:api",
+				"aws:lambda_function:worker",
+			),
+			Target: graphtest.ParseId(t, target),
+		}
+	}
+
+	sol, err := main.Engine.Run(context.Background(), newRequest("aws:lambda_function:api"))
+	require.NoError(t, err)
+	_, err = sol.DataflowGraph().Vertex(graphtest.ParseId(t, "aws:lambda_function:api"))
+	assert.NoError(t, err)
+	_, err = sol.DataflowGraph().Vertex(graphtest.ParseId(t, "aws:lambda_function:worker"))
+	assert.Error(t, err, "resources outside of the target's scope are not solved")
+
+	_, err = main.Engine.Run(context.Background(), newRequest("aws:lambda_function:other"))
+	var configErr engine_errs.InvalidConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, "target", configErr.Option)
+}
@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_VpcEndpoints(t *testing.T) {
+	main := EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	req := &SolveRequest{VpcEndpoints: true}
+	for _, id := range []string{"aws:ecs_service:svc", "aws:rds_instance:db", "aws:s3_bucket:bucket"} {
+		req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+			Operator: constraints.AddConstraintOperator,
+			Node:     graphtest.ParseId(t, id),
+		})
+	}
+	for _, target := range []string{"aws:rds_instance:db", "aws:s3_bucket:bucket"} {
+		req.Constraints.Edges = append(req.Constraints.Edges, constraints.EdgeConstraint{
+			Operator: constraints.MustExistConstraintOperator,
+			Target:   constraints.Edge{Source: graphtest.ParseId(t, "aws:ecs_service:svc"), Target: graphtest.ParseId(t, target)},
+		})
+	}
+	sol, err := main.Engine.Run(context.Background(), req)
+	require.NoError(t, err)
+
+	var endpoints []string
+	err = construct.WalkGraph(sol.DataflowGraph(), func(id construct.ResourceId, r *construct.Resource, nerr error) error {
+		if id.QualifiedTypeName() == "aws:vpc_endpoint" {
+			endpoints = append(endpoints, id.String())
+		}
+		return nerr
+	})
+	require.NoError(t, err)
+	// only the services used get an endpoint: s3 for the bucket, and logs for the service's log group
+	assert.ElementsMatch(t, []string{"aws:vpc_endpoint:vpc-0:logs", "aws:vpc_endpoint:vpc-0:s3"}, endpoints)
+
+	s3, err := sol.DataflowGraph().Vertex(graphtest.ParseId(t, "aws:vpc_endpoint:vpc-0:s3"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, s3.Properties["RouteTables"])
+	assert.NotEmpty(t, s3.Properties["Region"], "the endpoint is solved after it is added")
+	_, err = sol.DeploymentGraph().Edge(s3.ID, s3.Properties["RouteTables"].([]any)[0].(construct.ResourceId))
+	assert.NoError(t, err, "the endpoint is deployed after its route tables")
+}
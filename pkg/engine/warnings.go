@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Warnings collects the problems found during a run which do not stop it, such as resources pruned for being
+// unreachable, so that they can fail the run in strict mode.
+type Warnings struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Add logs the warning and records it.
+func (w *Warnings) Add(log *zap.SugaredLogger, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	log.Warn(msg)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = append(w.messages, msg)
+}
+
+// Messages returns the warnings in the order they were added.
+func (w *Warnings) Messages() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.messages...)
+}
+
+// Err returns an error listing all the warnings, or nil if there are none.
+func (w *Warnings) Err() error {
+	msgs := w.Messages()
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d warning(s) treated as errors: %s", len(msgs), strings.Join(msgs, "; "))
+}
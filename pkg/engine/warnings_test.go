@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWarnings(t *testing.T) {
+	log := zap.NewNop().Sugar()
+
+	var w Warnings
+	assert.NoError(t, w.Err())
+
+	w.Add(log, "pruned unreachable resource %s", "aws:subnet:vpc:subnet1")
+	w.Add(log, "pruned unreachable resource %s", "aws:route_table:vpc:rt")
+
+	assert.Equal(t, []string{
+		"pruned unreachable resource aws:subnet:vpc:subnet1",
+		"pruned unreachable resource aws:route_table:vpc:rt",
+	}, w.Messages())
+	assert.EqualError(t, w.Err(),
+		"2 warning(s) treated as errors: pruned unreachable resource aws:subnet:vpc:subnet1; pruned unreachable resource aws:route_table:vpc:rt")
+}
+
+func TestRun_Strict(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []any
+		// prunedDashboard adds a dashboard which is pruned, by classifying it as compute so that it is not kept as
+		// supporting the function it graphs
+		prunedDashboard bool
+		wantErr         string
+		wantStrictErr   string
+	}{
+		{
+			name:          "unsupported construct",
+			initial:       []any{"klotho:teleporter:tp"},
+			wantErr:       "construct klotho:teleporter:tp: type klotho:teleporter is not supported by any provider",
+			wantStrictErr: "construct klotho:teleporter:tp: type klotho:teleporter is not supported by any provider",
+		},
+		{
+			name:            "pruned resource",
+			initial:         []any{"aws:lambda_function:fn"},
+			prunedDashboard: true,
+			wantStrictErr:   "1 warning(s) treated as errors: pruned unreachable resource aws:cloudwatch_dashboard:dashboard",
+		},
+		{
+			name:    "no warnings",
+			initial: []any{"aws:s3_bucket:bucket"},
+		},
+	}
+	for _, tt := range tests {
+		for _, strict := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s (strict: %t)", tt.name, strict), func(t *testing.T) {
+				main := EngineMain{}
+				require.NoError(t, main.AddEngine())
+				main.Engine.Strict = strict
+				if tt.prunedDashboard {
+					rt, err := main.Engine.Kb.GetResourceTemplate(graphtest.ParseId(t, "aws:cloudwatch_dashboard"))
+					require.NoError(t, err)
+					rt.Classification.Is = append(rt.Classification.Is, "compute")
+				}
+
+				_, err := main.Engine.Run(context.Background(), &SolveRequest{
+					InitialState:     graphtest.MakeGraph(t, construct.NewGraph(), tt.initial...),
+					PruneUnreachable: true,
+					Dashboard:        tt.prunedDashboard,
+				})
+				wantErr := tt.wantErr
+				if strict {
+					wantErr = tt.wantStrictErr
+				}
+				if wantErr != "" {
+					assert.EqualError(t, err, wantErr)
+					return
+				}
+				assert.NoError(t, err)
+			})
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/klothoplatform/klotho/pkg/infra/kubernetes"
 	statereader "github.com/klothoplatform/klotho/pkg/infra/state_reader"
 	statetemplate "github.com/klothoplatform/klotho/pkg/infra/state_reader/state_template"
+	"github.com/klothoplatform/klotho/pkg/infra/terraform"
 	kio "github.com/klothoplatform/klotho/pkg/io"
 	"github.com/klothoplatform/klotho/pkg/knowledgebase/reader"
 	"github.com/klothoplatform/klotho/pkg/logging"
@@ -31,13 +32,18 @@ var commonCfg struct {
 }
 
 var generateIacCfg struct {
-	provider   string
-	inputGraph string
-	outputDir  string
-	appName    string
-	verbose    bool
-	jsonLog    bool
-	profileTo  string
+	provider      string
+	inputGraph    string
+	previousGraph string
+	outputDir     string
+	appName       string
+	verbose       bool
+	jsonLog       bool
+	profileTo     string
+	componentName string
+	sortByType    bool
+	ignoreDeps    []string
+	stackRefs     string
 }
 
 var getImportConstraintsCfg struct {
@@ -70,11 +76,16 @@ func AddIacCli(root *cobra.Command) error {
 		RunE:  GenerateIac,
 	}
 	flags = generateCmd.Flags()
-	flags.StringVarP(&generateIacCfg.provider, "provider", "p", "pulumi", "Provider to use")
+	flags.StringVarP(&generateIacCfg.provider, "provider", "p", "pulumi", "Provider to use (pulumi or terraform)")
 	flags.StringVarP(&generateIacCfg.inputGraph, "input-graph", "i", "", "Input graph to use")
+	flags.StringVar(&generateIacCfg.previousGraph, "previous-graph", "", "Previously deployed graph, used to alias renamed resources")
 	flags.StringVarP(&generateIacCfg.outputDir, "output-dir", "o", "", "Output directory to use")
 	flags.StringVarP(&generateIacCfg.appName, "app-name", "a", "", "App name to use")
 	flags.StringVar(&generateIacCfg.profileTo, "profiling", "", "Profile to file")
+	flags.StringVar(&generateIacCfg.componentName, "component", "", "Wrap the generated resources in a Pulumi ComponentResource class with this name")
+	flags.BoolVar(&generateIacCfg.sortByType, "sort-by-type", false, "Order the generated resources by type within each dependency tier")
+	flags.StringArrayVar(&generateIacCfg.ignoreDeps, "ignore-dependency", nil, "Dependency ('source -> target') to leave out of the resource ordering, to break a false cycle. Pass the same dependencies ignored when running the engine")
+	flags.StringVar(&generateIacCfg.stackRefs, "stack-references", "", "YAML file of imported resources to read from other Pulumi stacks' outputs, keyed by resource ID")
 	root.AddCommand(generateCmd)
 
 	getLiveStateCmd := &cobra.Command{
@@ -179,10 +190,22 @@ func GenerateIac(cmd *cobra.Command, args []string) error {
 	}
 
 	solCtx := engine.NewSolution(cmd.Context(), kb, "", &constraints.Constraints{})
+	var ignoredDeps []construct.SimpleEdge
+	for _, dep := range generateIacCfg.ignoreDeps {
+		var edge construct.SimpleEdge
+		if err := edge.UnmarshalText([]byte(dep)); err != nil {
+			return fmt.Errorf("invalid ignored dependency %q: %w", dep, err)
+		}
+		ignoredDeps = append(ignoredDeps, edge)
+	}
+	solCtx.IgnoreDependencies(ignoredDeps)
 	err = solCtx.LoadGraph(input.Graph)
 	if err != nil {
 		return err
 	}
+	if err := solCtx.ValidateIgnoredDependencies(); err != nil {
+		return err
+	}
 	kubernetesPlugin := kubernetes.Plugin{
 		AppName: generateIacCfg.appName,
 		KB:      kb,
@@ -195,14 +218,35 @@ func GenerateIac(cmd *cobra.Command, args []string) error {
 	switch generateIacCfg.provider {
 	case "pulumi":
 		pulumiPlugin := iac.Plugin{
-			Config: &iac.PulumiConfig{AppName: generateIacCfg.appName},
-			KB:     kb,
+			Config:        &iac.PulumiConfig{AppName: generateIacCfg.appName},
+			KB:            kb,
+			ComponentName: generateIacCfg.componentName,
+			SortByType:    generateIacCfg.sortByType,
+		}
+		if generateIacCfg.stackRefs != "" {
+			pulumiPlugin.StackReferences, err = readStackReferences(generateIacCfg.stackRefs)
+			if err != nil {
+				return fmt.Errorf("failed to read stack references: %w", err)
+			}
+		}
+		if generateIacCfg.previousGraph != "" {
+			pulumiPlugin.PreviousGraph, err = readGraph(generateIacCfg.previousGraph)
+			if err != nil {
+				return fmt.Errorf("failed to read previous graph: %w", err)
+			}
 		}
 		iacFiles, err := pulumiPlugin.Translate(solCtx)
 		if err != nil {
 			return err
 		}
 		files = append(files, iacFiles...)
+	case "terraform":
+		terraformPlugin := terraform.Plugin{}
+		iacFiles, err := terraformPlugin.Translate(solCtx)
+		if err != nil {
+			return err
+		}
+		files = append(files, iacFiles...)
 	default:
 		return fmt.Errorf("provider %s not supported", generateIacCfg.provider)
 	}
@@ -213,3 +257,26 @@ func GenerateIac(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+func readGraph(path string) (construct.Graph, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var input construct.YamlGraph
+	err = yaml.NewDecoder(f).Decode(&input)
+	if err != nil {
+		return nil, err
+	}
+	return input.Graph, nil
+}
+
+func readStackReferences(path string) (map[construct.ResourceId]iac.StackReference, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return iac.ReadStackReferences(f)
+}
@@ -0,0 +1,147 @@
+package iac
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"go.uber.org/zap"
+)
+
+// DetectRenames compares the previous graph to the current graph and returns the resources that were renamed,
+// keyed by their new ID. A resource is considered renamed when it is the only resource of its type and namespace
+// that was removed and the only one that was added. Ambiguous changes are treated as replacements.
+func DetectRenames(previous, current construct.Graph) (map[construct.ResourceId]construct.ResourceId, error) {
+	type typeKey struct {
+		Provider, Type, Namespace string
+	}
+	keyOf := func(id construct.ResourceId) typeKey {
+		return typeKey{Provider: id.Provider, Type: id.Type, Namespace: id.Namespace}
+	}
+
+	prevAdj, err := previous.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not list previous graph's resources: %w", err)
+	}
+	currAdj, err := current.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not list current graph's resources: %w", err)
+	}
+
+	removed := make(map[typeKey][]construct.ResourceId)
+	for id := range prevAdj {
+		if _, ok := currAdj[id]; !ok {
+			removed[keyOf(id)] = append(removed[keyOf(id)], id)
+		}
+	}
+	added := make(map[typeKey][]construct.ResourceId)
+	for id := range currAdj {
+		if _, ok := prevAdj[id]; !ok {
+			added[keyOf(id)] = append(added[keyOf(id)], id)
+		}
+	}
+
+	renames := make(map[construct.ResourceId]construct.ResourceId)
+	for key, newIds := range added {
+		oldIds := removed[key]
+		if len(newIds) == 1 && len(oldIds) == 1 {
+			renames[newIds[0]] = oldIds[0]
+		}
+	}
+	return renames, nil
+}
+
+// renderAliases registers a stack transformation which adds an alias to each renamed resource's old name
+// so that Pulumi updates the resource in place instead of replacing it. Aliases are keyed by the resource's
+// Pulumi type and name, so that only the renamed resource is aliased and not other resources with the same name.
+func (tc *TemplatesCompiler) renderAliases(out io.Writer, renames map[construct.ResourceId]construct.ResourceId) error {
+	if len(renames) == 0 {
+		return nil
+	}
+	newIds := make([]construct.ResourceId, 0, len(renames))
+	for id := range renames {
+		newIds = append(newIds, id)
+	}
+	sort.Slice(newIds, func(i, j int) bool {
+		return newIds[i].String() < newIds[j].String()
+	})
+
+	aliases := make(map[string]TsList)
+	var keys []string
+	for _, id := range newIds {
+		rt, err := tc.ResourceTemplate(id)
+		if err != nil {
+			return err
+		}
+		typeToken, ok := pulumiTypeToken(rt.OutputType)
+		if !ok {
+			zap.S().Debugf("Not aliasing %s to %s: unknown Pulumi type for %s", id, renames[id], rt.OutputType)
+			continue
+		}
+		key := aliasKey(typeToken, id.Name)
+		if _, ok := aliases[key]; !ok {
+			keys = append(keys, key)
+		}
+		aliases[key] = append(aliases[key], templateString(renames[id].Name))
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintln(out, "const $aliases: Record<string, string[]> = {")
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		_, err = fmt.Fprintf(out, "\t%s: %s,\n", templateString(key), aliases[key])
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(out, `}
+pulumi.runtime.registerStackTransformation((args) => {
+	const aliases = $aliases[%s]
+	if (aliases === undefined) {
+		return undefined
+	}
+	return {
+		props: args.props,
+		opts: pulumi.mergeOptions(args.opts, { aliases: aliases.map((name) => ({ name })) }),
+	}
+})
+
+`, "`"+aliasKey("${args.type}", "${args.name}")+"`")
+	return err
+}
+
+func aliasKey(typeToken, name string) string {
+	return typeToken + "::" + name
+}
+
+// pulumiTypeToken returns the Pulumi type token (such as `aws:s3/bucket:Bucket`) of the resource class (such as
+// `aws.s3.Bucket`) created by a resource template, if it follows the token naming of Pulumi's bridged providers.
+func pulumiTypeToken(class string) (string, bool) {
+	parts := strings.Split(class, ".")
+	for _, part := range parts {
+		if !validIdentifierPattern.MatchString(part) {
+			return "", false
+		}
+	}
+	var pkg, module, name string
+	switch len(parts) {
+	case 2:
+		pkg, module, name = parts[0], "index", parts[1]
+	case 3:
+		pkg, module, name = parts[0], parts[1], parts[2]
+	default:
+		return "", false
+	}
+	switch pkg {
+	case "pulumi", "pulumi_k8s":
+		// not resources, or from a provider (Kubernetes) whose tokens are named differently
+		return "", false
+	}
+	return fmt.Sprintf("%s:%s/%s%s:%s", pkg, module, strings.ToLower(name[:1]), name[1:], name), true
+}
@@ -0,0 +1,103 @@
+package iac
+
+import (
+	"bytes"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectRenames(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []any
+		current  []any
+		want     map[string]string
+	}{
+		{
+			name:     "renamed resource",
+			previous: []any{"aws:s3_bucket:old-bucket", "aws:sqs_queue:queue"},
+			current:  []any{"aws:s3_bucket:new-bucket", "aws:sqs_queue:queue"},
+			want:     map[string]string{"aws:s3_bucket:new-bucket": "aws:s3_bucket:old-bucket"},
+		},
+		{
+			name:     "no changes",
+			previous: []any{"aws:s3_bucket:bucket"},
+			current:  []any{"aws:s3_bucket:bucket"},
+			want:     map[string]string{},
+		},
+		{
+			name:     "different type is not a rename",
+			previous: []any{"aws:s3_bucket:old"},
+			current:  []any{"aws:sqs_queue:new"},
+			want:     map[string]string{},
+		},
+		{
+			name:     "ambiguous renames are not aliased",
+			previous: []any{"aws:s3_bucket:a", "aws:s3_bucket:b"},
+			current:  []any{"aws:s3_bucket:c", "aws:s3_bucket:d"},
+			want:     map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			previous := graphtest.MakeGraph(t, construct.NewGraph(), tt.previous...)
+			current := graphtest.MakeGraph(t, construct.NewGraph(), tt.current...)
+
+			renames, err := DetectRenames(previous, current)
+			require.NoError(err)
+
+			got := make(map[string]string)
+			for newId, oldId := range renames {
+				got[newId.String()] = oldId.String()
+			}
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestRenderAliases(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	bucket := construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "new-bucket"}
+	tc := newTestCompiler(t, graphtest.MakeGraph(t, construct.NewGraph(), bucket))
+	renames := map[construct.ResourceId]construct.ResourceId{
+		bucket: {Provider: "aws", Type: "s3_bucket", Name: "old-bucket"},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(tc.renderAliases(buf, renames))
+	assert.Contains(buf.String(), `"aws:s3/bucket:Bucket::new-bucket": ["old-bucket"],`)
+	assert.Contains(buf.String(), `pulumi.runtime.registerStackTransformation(`)
+	assert.Contains(buf.String(), "const aliases = $aliases[`${args.type}::${args.name}`]")
+
+	buf.Reset()
+	require.NoError(tc.renderAliases(buf, nil))
+	assert.Empty(buf.String())
+}
+
+func Test_pulumiTypeToken(t *testing.T) {
+	tests := []struct {
+		class string
+		want  string
+	}{
+		{class: "aws.s3.Bucket", want: "aws:s3/bucket:Bucket"},
+		{class: "aws.iam.RolePolicyAttachment", want: "aws:iam/rolePolicyAttachment:RolePolicyAttachment"},
+		{class: "docker.Image", want: "docker:index/image:Image"},
+		{class: "pulumi.Output<string>"},
+		{class: "pulumi_k8s.helm.v3.Release"},
+		{class: "void"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.class, func(t *testing.T) {
+			got, ok := pulumiTypeToken(tt.class)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want != "", ok)
+		})
+	}
+}
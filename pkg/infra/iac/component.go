@@ -0,0 +1,144 @@
+package iac
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/properties"
+)
+
+// RenderComponent renders the resources inside the constructor of an exported pulumi.ComponentResource class called
+// name, parenting each resource to the component. Each output becomes a typed property of the component. The module
+// only declares the class, so it can be imported without creating any resources; see [renderComponentStack] for the
+// stack which creates the component.
+func (tc *TemplatesCompiler) RenderComponent(
+	buf *bytes.Buffer,
+	name string,
+	resources []construct.ResourceId,
+	outputs map[string]construct.Output,
+) error {
+	outputNames := sortedOutputNames(outputs)
+
+	fmt.Fprintf(buf, "export interface %sArgs {\n\tprotect?: boolean\n}\n\n", name)
+
+	fmt.Fprintf(buf, "export class %s extends pulumi.ComponentResource {\n", name)
+	for _, outputName := range outputNames {
+		fmt.Fprintf(buf, "\tpublic readonly %s: pulumi.Output<%s>\n", outputName, tc.outputType(outputs[outputName]))
+	}
+	buf.WriteString("\tpublic readonly urns: Record<string, pulumi.Output<string>>\n\n")
+
+	fmt.Fprintf(buf, `	constructor(name: string, args: %[1]sArgs = {}, opts?: pulumi.ComponentResourceOptions) {
+		super('klotho:index:%[1]s', name, args, opts)
+		const protect = args.protect ?? kloConfig.getBoolean('protect') ?? false
+
+`, name)
+
+	tc.inComponent = true
+	err := tc.renderStackReferences(buf)
+	if err == nil {
+		err = tc.RenderBody(buf, resources)
+	}
+	tc.inComponent = false
+	if err != nil {
+		return err
+	}
+
+	for _, outputName := range outputNames {
+		fmt.Fprintf(buf, "\t\tthis.%s = pulumi.output(%s)\n", outputName, outputValue(tc, outputs[outputName]))
+	}
+	buf.WriteString("\t\tthis.urns = {\n")
+	tc.renderUrnEntries(buf, resources, "\t\t\t")
+	buf.WriteString("\t\t}\n")
+	buf.WriteString("\t\tthis.registerOutputs({\n")
+	for _, outputName := range outputNames {
+		fmt.Fprintf(buf, "\t\t\t%[1]s: this.%[1]s,\n", outputName)
+	}
+	buf.WriteString("\t\t})\n\t}\n}\n")
+	return nil
+}
+
+// renderComponentStack renders the stack's index.ts for a component rendered by [TemplatesCompiler.RenderComponent]
+// into the module called name. The stack creates a single instance of the component and exports its outputs and URNs
+// the same way a stack without a component does.
+func renderComponentStack(buf *bytes.Buffer, name string, outputs map[string]construct.Output) {
+	fmt.Fprintf(buf, "const $component = new %s(pulumi.getProject())\n\n", name)
+	buf.WriteString("export const $outputs = {\n")
+	for _, outputName := range sortedOutputNames(outputs) {
+		fmt.Fprintf(buf, "\t%[1]s: $component.%[1]s,\n", outputName)
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("export const $urns = $component.urns\n")
+}
+
+func sortedOutputNames(outputs map[string]construct.Output) []string {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// outputType returns the TypeScript type of the output's value, or `any` if it is not known.
+func (tc *TemplatesCompiler) outputType(output construct.Output) string {
+	if output.Ref.IsZero() {
+		return valueType(output.Value)
+	}
+	if outputValue(tc, output) == "null" {
+		return "any"
+	}
+	rt, err := tc.kb.GetResourceTemplate(output.Ref.Resource)
+	if err != nil || rt == nil {
+		return "any"
+	}
+	return propertyType(rt.GetProperty(output.Ref.Property))
+}
+
+func propertyType(prop knowledgebase.Property) string {
+	switch prop := prop.(type) {
+	case *properties.StringProperty:
+		return "string"
+	case *properties.IntProperty, *properties.FloatProperty:
+		return "number"
+	case *properties.BoolProperty:
+		return "boolean"
+	case *properties.ListProperty:
+		if prop.ItemProperty != nil {
+			return propertyType(prop.ItemProperty) + "[]"
+		}
+		return "any[]"
+	case *properties.SetProperty:
+		if prop.ItemProperty != nil {
+			return propertyType(prop.ItemProperty) + "[]"
+		}
+		return "any[]"
+	case *properties.MapProperty:
+		if prop.ValueProperty != nil {
+			return fmt.Sprintf("Record<string, %s>", propertyType(prop.ValueProperty))
+		}
+		return "Record<string, any>"
+	}
+	return "any"
+}
+
+func valueType(value any) string {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "any[]"
+	case reflect.Map:
+		return "Record<string, any>"
+	}
+	return "any"
+}
@@ -0,0 +1,109 @@
+package iac
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	engine "github.com/klothoplatform/klotho/pkg/engine"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/reader"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderComponent(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	bucket := construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"}
+	g := graphtest.MakeGraph(t, construct.NewGraph(), &construct.Resource{
+		ID:         bucket,
+		Properties: construct.Properties{"ForceDestroy": true},
+	})
+	tc := newTestCompiler(t, g)
+
+	outputs := map[string]construct.Output{
+		"bucketArn": {Ref: construct.PropertyRef{Resource: bucket, Property: "Arn"}},
+		"stage":     {Value: "dev"},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(tc.RenderComponent(buf, "MyStack", []construct.ResourceId{bucket}, outputs))
+	out := buf.String()
+
+	assert.Contains(out, "export interface MyStackArgs {")
+	assert.Contains(out, "export class MyStack extends pulumi.ComponentResource {")
+	assert.Contains(out, "\tpublic readonly bucketArn: pulumi.Output<string>\n")
+	assert.Contains(out, "\tpublic readonly stage: pulumi.Output<string>\n")
+	assert.Contains(out, "super('klotho:index:MyStack', name, args, opts)")
+
+	// the resource is declared inside the constructor, before the outputs are assigned
+	constructor := strings.Index(out, "constructor(")
+	resource := strings.Index(out, "const bucket = new aws.s3.Bucket(")
+	assert.Contains(out, "pulumi.mergeOptions({ parent: this }, { protect: protect })",
+		"the resource should be parented to the component")
+	assert.NotContains(out, "registerStackTransformation")
+	assigned := strings.Index(out, "this.bucketArn = pulumi.output(bucket.arn)")
+	require.NotEqual(-1, resource)
+	require.NotEqual(-1, assigned)
+	assert.Less(constructor, resource)
+	assert.Less(resource, assigned)
+	assert.NotContains(out, "export const bucket_")
+
+	assert.Contains(out, `this.stage = pulumi.output("dev")`)
+	assert.Contains(out, "\t\t\tbucketArn: this.bucketArn,\n")
+	assert.NotContains(out, "new MyStack(", "importing the component should not create it")
+
+	buf.Reset()
+	renderComponentStack(buf, "MyStack", outputs)
+	out = buf.String()
+	assert.Contains(out, "const $component = new MyStack(pulumi.getProject())")
+	assert.Contains(out, "\tbucketArn: $component.bucketArn,\n")
+	assert.Contains(out, "export const $urns = $component.urns")
+}
+
+func TestPlugin_Translate_Component(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	subnet := construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "shared-subnet"}
+	refs := map[construct.ResourceId]StackReference{
+		subnet: {Stack: "acme/network/dev", Outputs: map[string]string{"Id": "sharedSubnetId"}},
+	}
+	kb, err := reader.NewKBFromFs(templates.ResourceTemplates, templates.EdgeTemplates, templates.Models)
+	require.NoError(err)
+	sol := engine.NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+	require.NoError(sol.LoadGraph(graphtest.MakeGraph(t, construct.NewGraph(), &construct.Resource{
+		ID:         subnet,
+		Properties: construct.Properties{"Id": "subnet-0123456789"},
+		Imported:   true,
+	})))
+
+	plugin := Plugin{Config: &PulumiConfig{AppName: "test"}, KB: kb, StackReferences: refs, ComponentName: "Network"}
+	files, err := plugin.Translate(sol)
+	require.NoError(err)
+
+	contents := make(map[string]string)
+	for _, f := range files {
+		buf := new(bytes.Buffer)
+		_, err := f.WriteTo(buf)
+		require.NoError(err)
+		contents[f.Path()] = buf.String()
+	}
+	require.Contains(contents, "Network.ts")
+
+	component := contents["Network.ts"]
+	assert.Contains(component, "export class Network extends pulumi.ComponentResource {")
+	assert.NotContains(component, "new Network(")
+	assert.Contains(component,
+		`const $stack_acme_network_dev = new pulumi.StackReference("acme/network/dev", undefined, { parent: this })`)
+	assert.Contains(component, ", undefined, { parent: this })", "the imported subnet should be parented to the component")
+
+	index := contents["index.ts"]
+	assert.Contains(index, "import { Network } from './Network'")
+	assert.Contains(index, "const $component = new Network(pulumi.getProject())")
+	assert.NotContains(index, "StackReference")
+}
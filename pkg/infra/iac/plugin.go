@@ -29,6 +29,22 @@ type (
 	Plugin struct {
 		Config *PulumiConfig
 		KB     knowledgebase.TemplateKB
+
+		// PreviousGraph is the previously deployed graph, if any. When set, renamed resources
+		// are aliased to their old names so they are not replaced.
+		PreviousGraph construct.Graph
+
+		// ComponentName, when set, wraps all generated resources in a pulumi.ComponentResource class of this
+		// name so the stack can be reused as a component. The class is exported from its own module, `<name>.ts`,
+		// which the stack's index.ts imports and instantiates.
+		ComponentName string
+
+		// StackReferences are the imported resources which are managed by other stacks, keyed by their ID.
+		StackReferences map[construct.ResourceId]StackReference
+
+		// SortByType, when set, renders the resources grouped by dependency tier and then by type, instead of
+		// in the order of the topological sort.
+		SortByType bool
 	}
 )
 
@@ -54,6 +70,12 @@ func (p Plugin) Translate(sol solution.Solution) ([]kio.File, error) {
 	if err != nil {
 		return nil, err
 	}
+	if p.ComponentName != "" && !validIdentifierPattern.MatchString(p.ComponentName) {
+		return nil, fmt.Errorf("component name %q is not a valid identifier", p.ComponentName)
+	}
+	if p.ComponentName == "index" {
+		return nil, fmt.Errorf("component name %q conflicts with the stack's index.ts", p.ComponentName)
+	}
 	// TODO We'll eventually want to split the output into different files, but we don't know exactly what that looks
 	// like yet. For now, just write to a single file, "index.ts"
 	buf := getBuffer()
@@ -67,9 +89,19 @@ func (p Plugin) Translate(sol solution.Solution) ([]kio.File, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error adding pulumi kubernetes providers: %w", err)
 	}
+	err = orderRolePolicyAttachments(sol.DeploymentGraph())
+	if err != nil {
+		return nil, fmt.Errorf("error ordering role policy attachments: %w", err)
+	}
+	err = validatePolicySizes(sol.DeploymentGraph())
+	if err != nil {
+		return nil, fmt.Errorf("invalid IAM policies: %w", err)
+	}
 	tc := &TemplatesCompiler{
-		graph:     sol.DeploymentGraph(),
-		templates: &templateStore{fs: templatesFS},
+		graph:           sol.DeploymentGraph(),
+		kb:              sol.KnowledgeBase(),
+		templates:       &templateStore{fs: templatesFS},
+		stackReferences: p.StackReferences,
 	}
 	tc.vars, err = VariablesFromGraph(tc.graph)
 	if err != nil {
@@ -85,25 +117,64 @@ func (p Plugin) Translate(sol solution.Solution) ([]kio.File, error) {
 		return nil, err
 	}
 
-	resources, err := construct.ReverseTopologicalSort(tc.graph)
-	if err != nil {
-		return nil, err
+	// A component's aliases are registered by the stack which creates it, rather than in the component's module
+	aliasesBuf := buf
+	if p.ComponentName != "" {
+		aliasesBuf = new(bytes.Buffer)
+	}
+	if p.PreviousGraph != nil {
+		renames, err := DetectRenames(p.PreviousGraph, tc.graph)
+		if err != nil {
+			return nil, fmt.Errorf("error detecting renamed resources: %w", err)
+		}
+		if err := tc.renderAliases(aliasesBuf, renames); err != nil {
+			return nil, err
+		}
 	}
 
-	var errs error
-	for _, r := range resources {
-		errs = errors.Join(errs, tc.RenderResource(buf, r))
-		buf.WriteString("\n")
+	if p.ComponentName == "" {
+		if err := tc.renderStackReferences(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	var resources []construct.ResourceId
+	if p.SortByType {
+		resources, err = sortByTier(tc.graph)
+	} else {
+		resources, err = construct.ReverseTopologicalSort(tc.graph)
 	}
-	if errs != nil {
-		return nil, errs
+	if err != nil {
+		return nil, err
 	}
 
-	buf.WriteString("\n")
-	renderStackOutputs(tc, buf, sol.Outputs())
+	var componentTs *kio.RawFile
+	if p.ComponentName != "" {
+		if err := tc.RenderComponent(buf, p.ComponentName, resources, sol.Outputs()); err != nil {
+			return nil, err
+		}
+		componentTs = &kio.RawFile{
+			FPath:   p.ComponentName + ".ts",
+			Content: make([]byte, buf.Len()),
+		}
+		copy(componentTs.Content, buf.Bytes())
+
+		buf.Reset()
+		buf.WriteString("import * as pulumi from '@pulumi/pulumi'\n")
+		fmt.Fprintf(buf, "import { %[1]s } from './%[1]s'\n\n", p.ComponentName)
+		buf.Write(aliasesBuf.Bytes())
+		renderComponentStack(buf, p.ComponentName, sol.Outputs())
+	} else {
+		if err := tc.RenderBody(buf, resources); err != nil {
+			return nil, err
+		}
 
-	buf.WriteString("\n")
-	tc.renderUrnMap(buf, resources)
+		buf.WriteString("\n")
+		renderStackOutputs(tc, buf, sol.Outputs())
+
+		buf.WriteString("\n")
+		tc.renderUrnMap(buf, resources)
+	}
 
 	indexTs := &kio.RawFile{
 		FPath:   `index.ts`,
@@ -135,6 +206,9 @@ func (p Plugin) Translate(sol solution.Solution) ([]kio.File, error) {
 	}
 
 	files := []kio.File{indexTs, pJson, pulumiYaml, pulumiStack, tsConfig}
+	if componentTs != nil {
+		files = append(files, componentTs)
+	}
 
 	dockerfiles, err := RenderDockerfiles(sol)
 	if err != nil {
@@ -154,37 +228,42 @@ func renderStackOutputs(tc *TemplatesCompiler, buf *bytes.Buffer, outputs map[st
 	}
 	sort.Strings(names)
 	for _, name := range names {
-		output := outputs[name]
-		if !output.Ref.IsZero() {
-			val, err := tc.PropertyRefValue(output.Ref)
-			if err != nil {
-				buf.WriteString(fmt.Sprintf("\t%s: null,\n", name))
-				continue
-			}
-			buf.WriteString(fmt.Sprintf("\t%s: %s,\n", name, val))
-		} else {
-			val, err := json.Marshal(output.Value)
-			if err != nil {
-				buf.WriteString(fmt.Sprintf("\t%s: null,\n", name))
-			} else {
-				buf.WriteString(fmt.Sprintf("\t%s: %s,\n", name, string(val)))
-			}
-		}
+		buf.WriteString(fmt.Sprintf("\t%s: %s,\n", name, outputValue(tc, outputs[name])))
 	}
 	buf.WriteString("}\n")
 }
 
+// outputValue returns the TypeScript expression for the output, or `null` if it cannot be rendered.
+func outputValue(tc *TemplatesCompiler, output construct.Output) string {
+	if !output.Ref.IsZero() {
+		val, err := tc.PropertyRefValue(output.Ref)
+		if err != nil {
+			return "null"
+		}
+		return fmt.Sprint(val)
+	}
+	val, err := json.Marshal(output.Value)
+	if err != nil {
+		return "null"
+	}
+	return string(val)
+}
+
 func (tc *TemplatesCompiler) renderUrnMap(buf *bytes.Buffer, resources []construct.ResourceId) {
 	buf.WriteString("export const $urns = {\n")
+	tc.renderUrnEntries(buf, resources, "\t")
+	buf.WriteString("}\n")
+}
+
+func (tc *TemplatesCompiler) renderUrnEntries(buf *bytes.Buffer, resources []construct.ResourceId, indent string) {
 	for _, id := range resources {
 		obj, ok := tc.vars[id]
 		if !ok {
 			continue
 		}
 		// in TS/JS, if the object doesn't have property `urn`, it will be `undefined` and will not throw any errors
-		buf.WriteString(fmt.Sprintf("\t\"%s\": (%s as any).urn,\n", id, obj))
+		buf.WriteString(fmt.Sprintf("%s\"%s\": (%s as any).urn,\n", indent, id, obj))
 	}
-	buf.WriteString("}\n")
 }
 
 func (p *Plugin) sanitizeConfig() error {
@@ -0,0 +1,86 @@
+package iac
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+const (
+	// managedPolicyMaxSize is the maximum number of characters (excluding whitespace) in a managed policy document
+	managedPolicyMaxSize = 6144
+	// inlinePoliciesMaxSize is the maximum total number of characters (excluding whitespace) of all the inline
+	// policy documents of a role
+	inlinePoliciesMaxSize = 10240
+)
+
+var (
+	iamPolicyId = construct.ResourceId{Provider: "aws", Type: "iam_policy"}
+	iamRoleId   = construct.ResourceId{Provider: "aws", Type: "iam_role"}
+)
+
+// validatePolicySizes checks that the IAM policy documents in the graph are within IAM's size limits, which otherwise
+// are only reported when the deployment fails.
+// References to other resources' properties are not resolved yet, so the sizes are estimated using the reference
+// itself (eg `aws:s3_bucket:bucket#Arn`), which is usually shorter than the ARN it resolves to.
+func validatePolicySizes(g construct.Graph) error {
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		switch {
+		case iamPolicyId.Matches(id):
+			size, err := policySize(resource.Properties["Policy"])
+			if err != nil {
+				return errors.Join(nerr, fmt.Errorf("could not get size of %s policy: %w", id, err))
+			}
+			if size > managedPolicyMaxSize {
+				nerr = errors.Join(nerr, fmt.Errorf(
+					"policy document of %s is %d characters, exceeding the limit of %d",
+					id, size, managedPolicyMaxSize,
+				))
+			}
+
+		case iamRoleId.Matches(id):
+			policies, _ := resource.Properties["InlinePolicies"].([]any)
+			total := 0
+			largest, largestSize := "", 0
+			for _, p := range policies {
+				policy, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				size, err := policySize(policy["Policy"])
+				if err != nil {
+					nerr = errors.Join(nerr, fmt.Errorf("could not get size of %s inline policy %v: %w", id, policy["Name"], err))
+					continue
+				}
+				total += size
+				if size > largestSize {
+					largest, largestSize = fmt.Sprint(policy["Name"]), size
+				}
+			}
+			if total > inlinePoliciesMaxSize {
+				nerr = errors.Join(nerr, fmt.Errorf(
+					"inline policies of %s total %d characters, exceeding the limit of %d (largest is %q at %d characters)",
+					id, total, inlinePoliciesMaxSize, largest, largestSize,
+				))
+			}
+		}
+		return nerr
+	})
+}
+
+// policySize returns the number of characters in the policy document, excluding whitespace.
+func policySize(policy any) (int, error) {
+	if policy == nil {
+		return 0, nil
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(policy); err != nil {
+		return 0, err
+	}
+	return len(bytes.TrimSpace(buf.Bytes())), nil
+}
@@ -0,0 +1,85 @@
+package iac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePolicySizes(t *testing.T) {
+	// policyDoc makes a policy document with n statements of about 100 characters each
+	policyDoc := func(n int) map[string]any {
+		var statements []any
+		for i := 0; i < n; i++ {
+			statements = append(statements, map[string]any{
+				"Effect":   "Allow",
+				"Action":   []any{"s3:GetObject"},
+				"Resource": []any{fmt.Sprintf("arn:aws:s3:::bucket-%d/%s", i, strings.Repeat("x", 30))},
+			})
+		}
+		return map[string]any{"Version": "2012-10-17", "Statement": statements}
+	}
+	inlinePolicies := func(docs ...map[string]any) []any {
+		var policies []any
+		for i, doc := range docs {
+			policies = append(policies, map[string]any{"Name": fmt.Sprintf("policy-%d", i), "Policy": doc})
+		}
+		return policies
+	}
+
+	tests := []struct {
+		name    string
+		graph   []any
+		wantErr string
+	}{
+		{
+			name: "within limits",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "iam_policy", Name: "policy"},
+					Properties: construct.Properties{"Policy": policyDoc(10)},
+				},
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+					Properties: construct.Properties{"InlinePolicies": inlinePolicies(policyDoc(10), policyDoc(10))},
+				},
+			},
+		},
+		{
+			name: "oversized managed policy",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "iam_policy", Name: "policy"},
+					Properties: construct.Properties{"Policy": policyDoc(100)},
+				},
+			},
+			wantErr: "policy document of aws:iam_policy:policy is",
+		},
+		{
+			name: "oversized inline policies",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+					Properties: construct.Properties{"InlinePolicies": inlinePolicies(policyDoc(50), policyDoc(60))},
+				},
+			},
+			wantErr: `inline policies of aws:iam_role:role total`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+
+			err := validatePolicySizes(g)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
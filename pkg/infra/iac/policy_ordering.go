@@ -0,0 +1,64 @@
+package iac
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/collectionutil"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+var rolePolicyAttachmentId = construct.ResourceId{Provider: "aws", Type: "iam_role_policy_attachment"}
+
+// orderRolePolicyAttachments adds a dependency from each resource which uses an IAM role to the role's policy
+// attachments. Otherwise, Pulumi may create the resource (such as a lambda function invoked at create time)
+// as soon as the role exists, before its permissions have been attached.
+// Attachments which (transitively) depend on the user of the role are skipped to avoid cycles, such as
+// a policy which references the function that assumes the role.
+func orderRolePolicyAttachments(g construct.Graph) error {
+	attachments := make(map[construct.ResourceId][]construct.ResourceId)
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if !rolePolicyAttachmentId.Matches(id) {
+			return nerr
+		}
+		role, ok := resource.Properties["Role"].(construct.ResourceId)
+		if !ok {
+			return nerr
+		}
+		attachments[role] = append(attachments[role], id)
+		return nerr
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for role, roleAttachments := range attachments {
+		users, err := construct.DirectUpstreamDependencies(g, role)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for _, user := range users {
+			if rolePolicyAttachmentId.Matches(user) {
+				continue
+			}
+			for _, attachment := range roleAttachments {
+				attachmentDeps, err := construct.AllDownstreamDependencies(g, attachment)
+				if err != nil {
+					errs = errors.Join(errs, err)
+					continue
+				}
+				if collectionutil.Contains(attachmentDeps, user) {
+					continue
+				}
+				err = g.AddEdge(user, attachment, graph.EdgeData(construct.EdgeData{OrderingOnly: true}))
+				if err != nil && !errors.Is(err, graph.ErrEdgeAlreadyExists) {
+					errs = errors.Join(errs, fmt.Errorf("could not order %s after %s: %w", user, attachment, err))
+				}
+			}
+		}
+	}
+	return errs
+}
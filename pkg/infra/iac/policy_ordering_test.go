@@ -0,0 +1,68 @@
+package iac
+
+import (
+	"bytes"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRolePolicyAttachments(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	role := construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"}
+	policy := construct.ResourceId{Provider: "aws", Type: "iam_policy", Name: "policy"}
+	attachment := construct.ResourceId{Provider: "aws", Type: "iam_role_policy_attachment", Name: "attachment"}
+	lambda := construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "lambda"}
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: role},
+		&construct.Resource{ID: policy},
+		&construct.Resource{
+			ID:         attachment,
+			Properties: construct.Properties{"Role": role, "Policy": policy},
+		},
+		&construct.Resource{
+			ID:         lambda,
+			Properties: construct.Properties{"ExecutionRole": role},
+		},
+		"aws:iam_role_policy_attachment:attachment -> aws:iam_role:role",
+		"aws:iam_role_policy_attachment:attachment -> aws:iam_policy:policy",
+		"aws:lambda_function:lambda -> aws:iam_role:role",
+	)
+	require.NoError(orderRolePolicyAttachments(g))
+
+	_, err := g.Edge(lambda, attachment)
+	assert.NoError(err, "lambda should depend on its role's policy attachment")
+
+	tc := newTestCompiler(t, g)
+	buf := new(bytes.Buffer)
+	require.NoError(tc.RenderResource(buf, lambda))
+	assert.Contains(buf.String(), "dependsOn: [attachment, role]")
+}
+
+func TestOrderRolePolicyAttachments_SkipsCycles(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	role := construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"}
+	lambda := construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "lambda"}
+	attachment := construct.ResourceId{Provider: "aws", Type: "iam_role_policy_attachment", Name: "attachment"}
+
+	// The policy grants access to the function which assumes the role, so the function must be created first
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: role},
+		&construct.Resource{ID: lambda},
+		&construct.Resource{ID: attachment, Properties: construct.Properties{"Role": role}},
+		"aws:iam_role_policy_attachment:attachment -> aws:iam_role:role",
+		"aws:iam_role_policy_attachment:attachment -> aws:iam_policy:policy",
+		"aws:iam_policy:policy -> aws:lambda_function:lambda",
+		"aws:lambda_function:lambda -> aws:iam_role:role",
+	)
+	require.NoError(orderRolePolicyAttachments(g))
+
+	_, err := g.Edge(lambda, attachment)
+	assert.Error(err)
+}
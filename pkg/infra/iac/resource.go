@@ -21,6 +21,18 @@ type templateInputArgs map[string]any
 
 var validIdentifierPattern = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z_$0-9]*$`)
 
+// RenderBody renders each of the resources, in order, each followed by a blank line.
+func (tc *TemplatesCompiler) RenderBody(out io.Writer, resources []construct.ResourceId) error {
+	var errs error
+	for _, r := range resources {
+		errs = errors.Join(errs, tc.RenderResource(out, r))
+		if _, err := fmt.Fprintln(out); err != nil {
+			return errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
 func (tc *TemplatesCompiler) RenderResource(out io.Writer, rid construct.ResourceId) error {
 	resTmpl, err := tc.ResourceTemplate(rid)
 	if err != nil {
@@ -35,22 +47,31 @@ func (tc *TemplatesCompiler) RenderResource(out io.Writer, rid construct.Resourc
 		return err
 	}
 
+	// Annotate each resource with its ID so the generated code can be traced back to the graph
+	_, err = fmt.Fprintf(out, "// %s\n", rid)
+	if err != nil {
+		return err
+	}
 	if resTmpl.OutputType != "void" {
 		_, err = fmt.Fprintf(out, "const %s = ", tc.vars[rid])
 		if err != nil {
 			return err
 		}
 	}
+	createTmpl, importTmpl := resTmpl.Template, resTmpl.ImportResource
+	if tc.inComponent {
+		createTmpl, importTmpl = resTmpl.ComponentTemplate, resTmpl.ComponentImportResource
+	}
 	if r.Imported {
-		if resTmpl.ImportResource == nil {
+		if importTmpl == nil {
 			return fmt.Errorf("resource %s is imported but has no import resource template", rid)
 		}
-		err = resTmpl.ImportResource.Execute(out, inputs)
+		err = importTmpl.Execute(out, inputs)
 		if err != nil {
 			return fmt.Errorf("could not render resource %s: %w", rid, err)
 		}
 	} else {
-		err = resTmpl.Template.Execute(out, inputs)
+		err = createTmpl.Execute(out, inputs)
 		if err != nil {
 			return fmt.Errorf("could not render resource %s: %w", rid, err)
 		}
@@ -61,9 +82,14 @@ func (tc *TemplatesCompiler) RenderResource(out io.Writer, rid construct.Resourc
 		Object:   tc.vars[rid],
 		Input:    inputs,
 	}
+	// declarations cannot be exported from within a component's constructor
+	exportKeyword := "export "
+	if tc.inComponent {
+		exportKeyword = ""
+	}
 	var errs error
 	for export, tmpl := range resTmpl.Exports {
-		_, err = fmt.Fprintf(out, "\nexport const %s_%s = ", tc.vars[rid], export)
+		_, err = fmt.Fprintf(out, "\n%sconst %s_%s = ", exportKeyword, tc.vars[rid], export)
 		if err != nil {
 			errs = errors.Join(errs, fmt.Errorf("could not render export name %s: %w", export, err))
 			continue
@@ -215,6 +241,7 @@ func (tc *TemplatesCompiler) getInputArgs(r *construct.Resource, template *Resou
 	if errs != nil {
 		return templateInputArgs{}, errs
 	}
+	tc.stackReferenceInputs(r, inputs)
 
 	downstream, err := construct.DirectDownstreamDependencies(tc.graph, r.ID)
 	if err != nil {
@@ -277,6 +304,14 @@ func (tc *TemplatesCompiler) getInputArgs(r *construct.Resource, template *Resou
 		inputs["dependsOn"] = "[" + strings.Join(dependsOn, ", ") + "]"
 	}
 
+	if paths := tc.ignoreChanges(r.ID); len(paths) > 0 {
+		list := make(TsList, len(paths))
+		for i, p := range paths {
+			list[i] = templateString(p)
+		}
+		inputs["ignoreChanges"] = list
+	}
+
 	inputs["Name"] = templateString(r.ID.Name)
 
 	for g := range globalVariables {
@@ -286,6 +321,19 @@ func (tc *TemplatesCompiler) getInputArgs(r *construct.Resource, template *Resou
 	return inputs, nil
 }
 
+// ignoreChanges returns the property paths the resource's knowledge base template marks as managed out-of-band.
+// Resource templates opt in to them by passing `args.ignoreChanges` in their resource options.
+func (tc *TemplatesCompiler) ignoreChanges(id construct.ResourceId) []string {
+	if tc.kb == nil {
+		return nil
+	}
+	rt, err := tc.kb.GetResourceTemplate(id)
+	if err != nil || rt == nil {
+		return nil
+	}
+	return rt.IgnoreChanges
+}
+
 func (tc *TemplatesCompiler) useNestedTemplate(resTmpl *ResourceTemplate, val any, arg Arg) (string, error) {
 
 	var contents []byte
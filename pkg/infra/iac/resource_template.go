@@ -29,6 +29,11 @@ type (
 		Path              string
 		Exports           map[string]*template.Template
 		ImportResource    *template.Template
+
+		// ComponentTemplate and ComponentImportResource are the Template and ImportResource for rendering inside a
+		// component's constructor, which parent each of the resources to the component.
+		ComponentTemplate       *template.Template
+		ComponentImportResource *template.Template
 	}
 
 	PropertyTemplateData struct {
@@ -87,7 +92,11 @@ func (tc *TemplatesCompiler) ParseTemplate(name string, r io.Reader) (*ResourceT
 		return nil, err
 	}
 
-	rt.Template, rt.OutputType, err = createNodeToTemplate(node, name)
+	rt.Template, rt.OutputType, err = createNodeToTemplate(node, name, (*sitter.Node).Content)
+	if err != nil {
+		return nil, err
+	}
+	rt.ComponentTemplate, _, err = createNodeToTemplate(node, name, withComponentParent)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +117,11 @@ func (tc *TemplatesCompiler) ParseTemplate(name string, r io.Reader) (*ResourceT
 		return nil, err
 	}
 	var outputType string
-	rt.ImportResource, outputType, err = importFuncNodeToTemplate(node, name)
+	rt.ImportResource, outputType, err = importFuncNodeToTemplate(node, name, (*sitter.Node).Content)
+	if err != nil {
+		return nil, err
+	}
+	rt.ComponentImportResource, _, err = importFuncNodeToTemplate(node, name, withComponentParent)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +177,12 @@ func getReturn(node *sitter.Node) *sitter.Node {
 	return nil
 }
 
-func createNodeToTemplate(node *sitter.Node, name string) (*template.Template, string, error) {
+// createNodeToTemplate returns the template of the create function's body, using content to get the source of its nodes.
+func createNodeToTemplate(
+	node *sitter.Node,
+	name string,
+	content func(*sitter.Node) string,
+) (*template.Template, string, error) {
 	createFunc := doQuery(node, findCreateFuncQuery)
 	create, found := createFunc()
 	if !found {
@@ -173,13 +191,13 @@ func createNodeToTemplate(node *sitter.Node, name string) (*template.Template, s
 	outputType := create["return_type"].Content()
 	var expressionBody string
 	if outputType == "void" {
-		expressionBody = bodyContents(create["body"])
+		expressionBody = bodyContents(create["body"], content)
 	} else {
 		body := getReturn(create["body"])
 		if body == nil {
 			return nil, "", fmt.Errorf("no 'return' found in %s body:```\n%s\n```", name, create["body"].Content())
 		}
-		expressionBody = body.Content()
+		expressionBody = content(body)
 	}
 	expressionBody = parameterizeArgs(expressionBody, "")
 	expressionBody = templateComments.ReplaceAllString(expressionBody, "")
@@ -284,7 +302,11 @@ func exportsNodeToTemplate(tc *TemplatesCompiler, tmpl *ResourceTemplate, node *
 	return exportsTemplates, errs
 }
 
-func importFuncNodeToTemplate(node *sitter.Node, name string) (*template.Template, string, error) {
+func importFuncNodeToTemplate(
+	node *sitter.Node,
+	name string,
+	content func(*sitter.Node) string,
+) (*template.Template, string, error) {
 	importFunc := doQuery(node, findImportFunc)
 	imp, found := importFunc()
 	if !found {
@@ -296,7 +318,7 @@ func importFuncNodeToTemplate(node *sitter.Node, name string) (*template.Templat
 	if body == nil {
 		return nil, "", fmt.Errorf("no 'return' found in %s body:```\n%s\n```", name, imp["body"].Content())
 	}
-	expressionBody = body.Content()
+	expressionBody = content(body)
 
 	expressionBody = parameterizeArgs(expressionBody, "")
 	expressionBody = templateComments.ReplaceAllString(expressionBody, "")
@@ -332,9 +354,9 @@ func parseFile(r io.Reader) (*sitter.Node, error) {
 // bodyContents returns the contents of a 'statement_block' with the surrounding {}
 // and indentation removed so that the contents of a void function
 // can be inlined with the rest of the index.ts.
-func bodyContents(node *sitter.Node) string {
+func bodyContents(node *sitter.Node, content func(*sitter.Node) string) string {
 	if node.ChildCount() == 0 || node.Child(0).Content() != "{" {
-		return node.Content()
+		return content(node)
 	}
 	var buf strings.Builder
 	buf.Grow(len(node.Content()))
@@ -342,11 +364,88 @@ func bodyContents(node *sitter.Node) string {
 		if i > 0 {
 			buf.WriteRune('\n')
 		}
-		buf.WriteString(node.NamedChild(i).Content())
+		buf.WriteString(content(node.NamedChild(i)))
 	}
 	return strings.TrimSuffix(buf.String(), ";") // Remove any trailing ';' since one is added later to prevent ';;'
 }
 
+// componentParent is the resource option which parents a resource to the component whose constructor creates it.
+const componentParent = "{ parent: this }"
+
+// withComponentParent returns the contents of the node with [componentParent] merged into the options of each resource
+// it creates (`new aws.s3.Bucket(name, args, opts)`) or reads (`aws.s3.Bucket.get(name, id, state, opts)`). Options
+// which set their own parent (such as for a resource's child resources) keep it.
+func withComponentParent(node *sitter.Node) string {
+	type insert struct {
+		at   uint32
+		text string
+	}
+	var inserts []insert
+	// addOptions adds the parent to the resource options, the argument at index opts
+	addOptions := func(args []*sitter.Node, opts int) {
+		switch {
+		case len(args) > opts:
+			inserts = append(inserts,
+				insert{at: args[opts].StartByte(), text: fmt.Sprintf("pulumi.mergeOptions(%s, ", componentParent)},
+				insert{at: args[opts].EndByte(), text: ")"},
+			)
+		default:
+			text := strings.Repeat(", undefined", opts-len(args)) + ", " + componentParent
+			inserts = append(inserts, insert{at: args[len(args)-1].EndByte(), text: text})
+		}
+	}
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		switch n.Type() {
+		case "new_expression":
+			if args := callArguments(n); isResourceType(n.ChildByFieldName("constructor")) && len(args) >= 2 {
+				addOptions(args, 2)
+			}
+		case "call_expression":
+			fn := n.ChildByFieldName("function")
+			if fn != nil && fn.Type() == "member_expression" && fn.ChildByFieldName("property").Content() == "get" {
+				if args := callArguments(n); isResourceType(fn.ChildByFieldName("object")) && len(args) >= 2 {
+					addOptions(args, 3)
+				}
+			}
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(node)
+
+	// Insert from the end so that the offsets of the remaining inserts are unchanged
+	sort.SliceStable(inserts, func(i, j int) bool { return inserts[i].at > inserts[j].at })
+	content := []byte(node.Content())
+	for _, ins := range inserts {
+		at := ins.at - node.StartByte()
+		content = append(content[:at], append([]byte(ins.text), content[at:]...)...)
+	}
+	return string(content)
+}
+
+// isResourceType returns whether the node is a qualified type from a provider's package, such as `aws.s3.Bucket`.
+func isResourceType(node *sitter.Node) bool {
+	return node != nil && node.Type() == "member_expression" && !strings.HasPrefix(node.Content(), "pulumi.")
+}
+
+// callArguments returns the arguments of the call or new expression, excluding comments.
+func callArguments(node *sitter.Node) []*sitter.Node {
+	argsNode := node.ChildByFieldName("arguments")
+	if argsNode == nil {
+		return nil
+	}
+	var args []*sitter.Node
+	for i := 0; i < int(argsNode.NamedChildCount()); i++ {
+		if arg := argsNode.NamedChild(i); arg.Type() != "comment" {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
 var (
 	curlyArgsEscapes      = regexp.MustCompile(`({+)(args\.)`)
 	parameterizeArgsRegex = regexp.MustCompile(`\bargs(\.\w+)`)
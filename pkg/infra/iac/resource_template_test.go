@@ -69,3 +69,57 @@ func Test_parseArgs(t *testing.T) {
 		})
 	}
 }
+
+func Test_withComponentParent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "without options",
+			content: `new aws.s3.Bucket(args.Name, { forceDestroy: true })`,
+			want:    `new aws.s3.Bucket(args.Name, { forceDestroy: true }, { parent: this })`,
+		},
+		{
+			name:    "with options",
+			content: `new aws.s3.Bucket(args.Name, {}, { protect: args.protect })`,
+			want:    `new aws.s3.Bucket(args.Name, {}, pulumi.mergeOptions({ parent: this }, { protect: args.protect }))`,
+		},
+		{
+			name: "child resource",
+			content: `(() => {
+	const bucket = new aws.s3.Bucket(args.Name, {})
+	new aws.s3.BucketPolicy(args.Name, {}, { parent: bucket })
+	return bucket
+})()`,
+			want: `(() => {
+	const bucket = new aws.s3.Bucket(args.Name, {}, { parent: this })
+	new aws.s3.BucketPolicy(args.Name, {}, pulumi.mergeOptions({ parent: this }, { parent: bucket }))
+	return bucket
+})()`,
+		},
+		{
+			name:    "not a resource",
+			content: `new pulumi.asset.FileArchive(args.Code)`,
+			want:    `new pulumi.asset.FileArchive(args.Code)`,
+		},
+		{
+			name:    "import",
+			content: `aws.s3.Bucket.get(args.Name, args.Id)`,
+			want:    `aws.s3.Bucket.get(args.Name, args.Id, undefined, { parent: this })`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := sitter.NewParser()
+			parser.SetLanguage(tsLang)
+			tree, err := parser.ParseCtx(context.TODO(), nil, []byte(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.want, withComponentParent(tree.RootNode()))
+		})
+	}
+}
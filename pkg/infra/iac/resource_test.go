@@ -0,0 +1,1907 @@
+package iac
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/reader"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    []any
+		render   string
+		contains []string
+	}{
+		{
+			name: "dynamodb table infrequent access class",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+					Properties: construct.Properties{
+						"Attributes":  []any{map[string]any{"Name": "id", "Type": "S"}},
+						"HashKey":     "id",
+						"BillingMode": "PAY_PER_REQUEST",
+						"TableClass":  "STANDARD_INFREQUENT_ACCESS",
+					},
+				},
+			},
+			render: "aws:dynamodb_table:table",
+			contains: []string{
+				`tableClass: "STANDARD_INFREQUENT_ACCESS"`,
+			},
+		},
+		{
+			name: "dynamodb table customer managed key",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "table-key"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+					Properties: construct.Properties{
+						"Attributes":  []any{map[string]any{"Name": "id", "Type": "S"}},
+						"HashKey":     "id",
+						"BillingMode": "PAY_PER_REQUEST",
+						"KmsKey":      construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "table-key"},
+					},
+				},
+			},
+			render: "aws:dynamodb_table:table",
+			contains: []string{
+				"serverSideEncryption: {\n                enabled: true,\n                kmsKeyArn: table_key.arn,",
+			},
+		},
+		{
+			name: "sqs queue message retention",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "sqs_queue", Name: "jobs"},
+					Properties: construct.Properties{
+						"FifoQueue":              true,
+						"VisibilityTimeout":      180,
+						"MessageRetentionPeriod": 86400,
+					},
+				},
+			},
+			render: "aws:sqs_queue:jobs",
+			contains: []string{
+				`fifoQueue: true,`,
+				`visibilityTimeoutSeconds: 180,`,
+				`messageRetentionSeconds: 86400,`,
+			},
+		},
+		{
+			name: "cloudwatch dashboard lambda metrics",
+			graph: []any{
+				"aws:lambda_function:fn",
+				"aws:region:region-0",
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudwatch_dashboard", Name: "dashboard"},
+					Properties: construct.Properties{
+						"DashboardBody": map[string]any{
+							"Widgets": []any{
+								map[string]any{
+									"Type": "metric",
+									"Properties": map[string]any{
+										"Title":  "fn",
+										"Region": construct.PropertyRef{Resource: construct.ResourceId{Provider: "aws", Type: "region", Name: "region-0"}, Property: "Name"},
+										"Metrics": []any{
+											[]any{"AWS/Lambda", "Invocations", "FunctionName", construct.PropertyRef{
+												Resource: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+												Property: "FunctionName",
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			render: "aws:cloudwatch_dashboard:dashboard",
+			contains: []string{
+				`metrics: [["AWS/Lambda", "Invocations", "FunctionName", fn.name]]`,
+				`region: region_0.apply((o) => o.name)`,
+				`title: "fn"`,
+			},
+		},
+		{
+			name: "imported secret",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "secret", Name: "db-credentials"},
+					Properties: construct.Properties{
+						"Arn": "arn:aws:secretsmanager:us-east-1:123456789012:secret:db-credentials-AbCdEf",
+					},
+					Imported: true,
+				},
+			},
+			render: "aws:secret:db-credentials",
+			contains: []string{
+				`const db_credentials = aws.secretsmanager.Secret.get("db-credentials", "arn:aws:secretsmanager:us-east-1:123456789012:secret:db-credentials-AbCdEf")`,
+			},
+		},
+		{
+			name: "imported vpc",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+					Properties: construct.Properties{"Id": "vpc-123"},
+					Imported:   true,
+				},
+			},
+			render: "aws:vpc:vpc",
+			contains: []string{
+				`const vpc = aws.ec2.Vpc.get("vpc", "vpc-123")`,
+			},
+		},
+		{
+			name: "imported subnet",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "subnet1"},
+					Properties: construct.Properties{"Id": "subnet-0aaa"},
+					Imported:   true,
+				},
+			},
+			render: "aws:subnet:vpc:subnet1",
+			contains: []string{
+				`aws.ec2.Subnet.get("subnet1", "subnet-0aaa")`,
+			},
+		},
+		{
+			name: "mysql connection string",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+					Properties: construct.Properties{
+						"DatabaseName": "main",
+						"Engine":       "mariadb",
+					},
+				},
+				"aws:secret:db-credentials",
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "secret_version", Name: "db-credentials"},
+					Properties: construct.Properties{
+						"Secret": construct.ResourceId{Provider: "aws", Type: "secret", Name: "db-credentials"},
+						"Type":   "string",
+						"Content": construct.PropertyRef{
+							Resource: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+							Property: "ConnectionString",
+						},
+					},
+				},
+			},
+			render: "aws:secret_version:db-credentials",
+			contains: []string{
+				"secretString: pulumi.interpolate`${db.engine.apply((engine) => (/mysql|mariadb/.test(engine) ? 'mysql' : engine))}://",
+			},
+		},
+		{
+			name: "web acl with rate based rule",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "web_acl", Name: "acl"},
+					Properties: construct.Properties{
+						"Scope":         "REGIONAL",
+						"DefaultAction": "allow",
+						"ManagedRuleGroups": []any{
+							map[string]any{"Name": "AWSManagedRulesCommonRuleSet", "VendorName": "AWS", "Priority": 0},
+						},
+						"RateBasedRules": []any{
+							map[string]any{"Name": "per-ip", "Limit": 500, "Action": "block", "Priority": 1},
+						},
+					},
+				},
+			},
+			render: "aws:web_acl:acl",
+			contains: []string{
+				`defaultAction: { "allow": {} },`,
+				`vendorName: "AWS",`,
+				`action: { "block": {} },`,
+				`limit: 500,`,
+			},
+		},
+		{
+			name: "task definition with log router sidecar",
+			graph: []any{
+				"aws:iam_role:svc-execution-role",
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "ecs_task_definition", Name: "svc"},
+					Properties: construct.Properties{
+						"Cpu":           "256",
+						"Memory":        "512",
+						"NetworkMode":   "awsvpc",
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "svc-execution-role"},
+						"TaskRole":      construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "svc-execution-role"},
+						"ContainerDefinitions": []any{
+							map[string]any{
+								"Name":      "svc",
+								"Image":     "nginx:latest",
+								"Essential": true,
+								"LogConfiguration": map[string]any{
+									"LogDriver": "awsfirelens",
+									"Options":   map[string]any{"Name": "cloudwatch"},
+								},
+								"PortMappings": []any{
+									map[string]any{"ContainerPort": 80, "HostPort": 80, "Protocol": "TCP"},
+								},
+							},
+							map[string]any{
+								"Name":      "log-router",
+								"Image":     "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable",
+								"Essential": false,
+								"FirelensConfiguration": map[string]any{
+									"Type":    "fluentbit",
+									"Options": map[string]any{"enable-ecs-log-metadata": "true"},
+								},
+							},
+						},
+					},
+				},
+			},
+			render: "aws:ecs_task_definition:svc",
+			contains: []string{
+				`name: "svc",`,
+				`logDriver: "awsfirelens",`,
+				`essential: false,
+        firelensConfiguration: {
+            type: "fluentbit",
+            options: {
+                "enable-ecs-log-metadata": "true",
+            },
+        },
+        image: "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable",`,
+				`name: "log-router",`,
+			},
+		},
+		{
+			name: "lambda json structured logs",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "log_group", Name: "fn-logs"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+					Properties: construct.Properties{
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+						"Code":          "fn.zip",
+						"LogConfig": map[string]any{
+							"Format":              "JSON",
+							"ApplicationLogLevel": "DEBUG",
+							"SystemLogLevel":      "WARN",
+							"LogGroup":            construct.ResourceId{Provider: "aws", Type: "log_group", Name: "fn-logs"},
+						},
+					},
+				},
+			},
+			render: "aws:lambda_function:fn",
+			contains: []string{
+				`logFormat: "JSON",`,
+				`applicationLogLevel: "DEBUG",`,
+				`systemLogLevel: "WARN",`,
+				`logGroup: fn_logs.name,`,
+			},
+		},
+		{
+			name: "generated mysql rds password",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+					Properties: construct.Properties{
+						"SubnetGroup":    construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+						"SecurityGroups": []any{},
+						"DatabaseName":   "main",
+						"Engine":         "mysql",
+						"PasswordLength": 24,
+					},
+				},
+			},
+			render: "aws:rds_instance:db",
+			contains: []string{
+				"kloConfig.getSecret(`${\"db\"}-password`) ??",
+				"new random.RandomPassword(`${\"db\"}-password`, {",
+				`/mysql|mariadb/.test("mysql") ? 41 : 128`,
+				`overrideSpecial: '!*()-_.~',`,
+				`minSpecial: 1,`,
+			},
+		},
+		{
+			name: "lambda target group",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_permission", Name: "tg-fn"},
+					Properties: construct.Properties{
+						"Function":  construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+						"Principal": "elasticloadbalancing.amazonaws.com",
+						"Action":    "lambda:InvokeFunction",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "target_group", Name: "tg"},
+					Properties: construct.Properties{
+						"TargetType": "lambda",
+						"Targets": []any{
+							map[string]any{
+								"Id": construct.PropertyRef{
+									Resource: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+									Property: "Arn",
+								},
+								"Port": 80,
+							},
+						},
+					},
+				},
+				"aws:target_group:tg -> aws:lambda_permission:tg-fn",
+				"aws:lambda_permission:tg-fn -> aws:lambda_function:fn",
+			},
+			render: "aws:target_group:tg",
+			contains: []string{
+				"targetType: \"lambda\",\n",
+				`for (const [i, target] of [{id: fn.arn, port: 80}].entries()) {`,
+				`{ dependsOn: [tg_fn] }`,
+			},
+		},
+		{
+			name: "dynamodb table stream and global secondary index",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+					Properties: construct.Properties{
+						"Attributes": []any{
+							map[string]any{"Name": "id", "Type": "S"},
+							map[string]any{"Name": "owner", "Type": "S"},
+						},
+						"HashKey":     "id",
+						"BillingMode": "PAY_PER_REQUEST",
+						"GlobalSecondaryIndexes": []any{
+							map[string]any{"Name": "by-owner", "HashKey": "owner", "ProjectionType": "ALL"},
+						},
+						"StreamViewType": "NEW_AND_OLD_IMAGES",
+					},
+				},
+			},
+			render: "aws:dynamodb_table:table",
+			contains: []string{
+				`globalSecondaryIndexes: [{hashKey: "owner", name: "by-owner", projectionType: "ALL"}],`,
+				`streamEnabled: true,`,
+				`streamViewType: "NEW_AND_OLD_IMAGES",`,
+			},
+		},
+		{
+			name: "dynamodb stream event source mapping",
+			graph: []any{
+				"aws:dynamodb_table:table",
+				"aws:lambda_function:fn",
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_event_source_mapping", Name: "table-fn"},
+					Properties: construct.Properties{
+						"EventSource":      construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+						"Function":         construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+						"StartingPosition": "LATEST",
+					},
+				},
+			},
+			render: "aws:lambda_event_source_mapping:table-fn",
+			contains: []string{
+				`table instanceof aws.dynamodb.Table`,
+				`? table.streamArn`,
+			},
+		},
+		{
+			name: "iam role inline policy description comment",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+					Properties: construct.Properties{
+						"AssumeRolePolicyDoc": map[string]any{"Version": "2012-10-17"},
+						"InlinePolicies": []any{
+							map[string]any{
+								"Name":        "data-policy",
+								"Description": "Read objects in aws:s3_bucket:data",
+								"Policy": map[string]any{
+									"Version": "2012-10-17",
+									"Statement": []any{map[string]any{
+										"Action":   []any{"s3:GetObject"},
+										"Effect":   "Allow",
+										"Resource": []any{"*"},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			render: "aws:iam_role:role",
+			contains: []string{
+				"    // Read objects in aws:s3_bucket:data\n    {\n        name: \"data-policy\",",
+			},
+		},
+		{
+			name: "lambda errors alarm",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudwatch_alarm", Name: "fn-errors"},
+					Properties: construct.Properties{
+						"ComparisonOperator": "GreaterThanOrEqualToThreshold",
+						"EvaluationPeriods":  1,
+						"MetricName":         "Errors",
+						"Namespace":          "AWS/Lambda",
+						"Statistic":          "Sum",
+						"Threshold":          1,
+						"Dimensions": map[string]any{
+							"FunctionName": construct.PropertyRef{
+								Resource: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+								Property: "FunctionName",
+							},
+						},
+					},
+				},
+				"aws:lambda_function:fn -> aws:cloudwatch_alarm:fn-errors",
+			},
+			render: "aws:cloudwatch_alarm:fn-errors",
+			contains: []string{
+				`dimensions: {FunctionName: fn.name},`,
+				`metricName: "Errors",`,
+				`namespace: "AWS/Lambda",`,
+			},
+		},
+		{
+			name: "s3 object detected content type with cache control",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "site"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_object", Namespace: "site", Name: "app.js"},
+					Properties: construct.Properties{
+						"Bucket":       construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "site"},
+						"Key":          "static/app.js",
+						"FilePath":     "dist/static/app.js",
+						"CacheControl": "public, max-age=31536000, immutable",
+					},
+				},
+				"aws:s3_object:site:app.js -> aws:s3_bucket:site",
+			},
+			render: "aws:s3_object:site:app.js",
+			contains: []string{
+				`contentType: mime.getType("dist/static/app.js") || undefined,`,
+				`cacheControl: "public, max-age=31536000, immutable",`,
+			},
+		},
+		{
+			name: "s3 object explicit content type",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "site"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_object", Namespace: "site", Name: "app.js"},
+					Properties: construct.Properties{
+						"Bucket":      construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "site"},
+						"Key":         "static/app.js",
+						"FilePath":    "dist/static/app.js",
+						"ContentType": "text/javascript; charset=utf-8",
+					},
+				},
+				"aws:s3_object:site:app.js -> aws:s3_bucket:site",
+			},
+			render: "aws:s3_object:site:app.js",
+			contains: []string{
+				`contentType: "text/javascript; charset=utf-8",`,
+			},
+		},
+		{
+			name: "route53 alias record to load balancer",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+					Properties: construct.Properties{"DomainName": "example.com"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route53_record", Namespace: "zone", Name: "api"},
+					Properties: construct.Properties{
+						"HostedZone": construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+						"RecordName": "api.example.com",
+						"Type":       "A",
+						"Ttl":        300,
+						"Alias": map[string]any{
+							"Name": construct.PropertyRef{
+								Resource: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+								Property: "DnsName",
+							},
+							"ZoneId": construct.PropertyRef{
+								Resource: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+								Property: "ZoneId",
+							},
+							"EvaluateTargetHealth": true,
+						},
+					},
+				},
+				"aws:route53_record:zone:api -> aws:route53_hosted_zone:zone",
+				"aws:route53_record:zone:api -> aws:load_balancer:lb",
+			},
+			render: "aws:route53_record:zone:api",
+			contains: []string{
+				`zoneId: zone.zoneId,`,
+				`name: "api.example.com",`,
+				"name: lb.dnsName,\n",
+				"zoneId: lb.zoneId,\n",
+				"evaluateTargetHealth: true,\n",
+			},
+		},
+		{
+			name: "route53 cname record",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+					Properties: construct.Properties{"DomainName": "example.com"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route53_record", Namespace: "zone", Name: "docs"},
+					Properties: construct.Properties{
+						"HostedZone": construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+						"RecordName": "docs.example.com",
+						"Type":       "CNAME",
+						"Ttl":        300,
+						"Records":    []any{"example.github.io"},
+					},
+				},
+				"aws:route53_record:zone:docs -> aws:route53_hosted_zone:zone",
+			},
+			render: "aws:route53_record:zone:docs",
+			contains: []string{
+				`type: "CNAME",`,
+				`ttl: 300,`,
+				`records: ["example.github.io"],`,
+			},
+		},
+		{
+			name: "vpc secondary cidr block for isolated subnets",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc_cidr_block_association", Namespace: "vpc", Name: "vpc-isolated"},
+					Properties: construct.Properties{
+						"Vpc":       construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"CidrBlock": "10.1.0.0/16",
+					},
+				},
+				"aws:vpc_cidr_block_association:vpc:vpc-isolated -> aws:vpc:vpc",
+			},
+			render: "aws:vpc_cidr_block_association:vpc:vpc-isolated",
+			contains: []string{
+				`new aws.ec2.VpcIpv4CidrBlockAssociation("vpc-isolated", {`,
+				`vpcId: vpc.id,`,
+				`cidrBlock: "10.1.0.0/16",`,
+			},
+		},
+		{
+			name: "https listener waits for certificate validation",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+					Properties: construct.Properties{
+						"DomainName": "api.example.com",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "acm_certificate_validation", Name: "cert-validation"},
+					Properties: construct.Properties{
+						"Certificate": construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "load_balancer_listener", Namespace: "lb", Name: "https"},
+					Properties: construct.Properties{
+						"LoadBalancer": construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+						"Certificate":  construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+						"Port":         443,
+						"Protocol":     "HTTPS",
+					},
+				},
+				"aws:load_balancer_listener:lb:https -> aws:load_balancer:lb",
+				"aws:load_balancer_listener:lb:https -> aws:acm_certificate:cert",
+				"aws:load_balancer_listener:lb:https -> aws:acm_certificate_validation:cert-validation",
+				"aws:acm_certificate_validation:cert-validation -> aws:acm_certificate:cert",
+			},
+			render: "aws:load_balancer_listener:lb:https",
+			contains: []string{
+				`certificateArn: cert.arn,`,
+				`protocol: "HTTPS",`,
+				`dependsOn: [cert, cert_validation, lb]`,
+			},
+		},
+		{
+			name: "certificate dns validation record",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+					Properties: construct.Properties{
+						"DomainName": "api.example.com",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route53_record", Namespace: "zone", Name: "cert-validation"},
+					Properties: construct.Properties{
+						"HostedZone": construct.ResourceId{Provider: "aws", Type: "route53_hosted_zone", Name: "zone"},
+						"RecordName": construct.PropertyRef{
+							Resource: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+							Property: "ValidationRecordName",
+						},
+						"Type": "CNAME",
+						"Ttl":  60,
+						"Records": []any{
+							construct.PropertyRef{
+								Resource: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+								Property: "ValidationRecordValue",
+							},
+						},
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "acm_certificate_validation", Name: "cert-validation"},
+					Properties: construct.Properties{
+						"Certificate":      construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+						"ValidationRecord": construct.ResourceId{Provider: "aws", Type: "route53_record", Namespace: "zone", Name: "cert-validation"},
+					},
+				},
+				"aws:route53_record:zone:cert-validation -> aws:route53_hosted_zone:zone",
+				"aws:acm_certificate_validation:cert-validation -> aws:acm_certificate:cert",
+				"aws:acm_certificate_validation:cert-validation -> aws:route53_record:zone:cert-validation",
+			},
+			render: "aws:acm_certificate_validation:cert-validation",
+			contains: []string{
+				`certificateArn: cert.arn,`,
+				`validationRecordFqdns: [route53_record_cert_validation.fqdn],`,
+			},
+		},
+		{
+			name: "cloudfront distribution with acm certificate",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+					Properties: construct.Properties{
+						"DomainName": "www.example.com",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudfront_distribution", Name: "cdn"},
+					Properties: construct.Properties{
+						"DefaultCacheBehavior": map[string]any{"TargetOriginId": "bucket"},
+						"ViewerCertificate": map[string]any{
+							"AcmCertificateArn": construct.PropertyRef{
+								Resource: construct.ResourceId{Provider: "aws", Type: "acm_certificate", Name: "cert"},
+								Property: "Arn",
+							},
+							"SslSupportMethod":             "sni-only",
+							"MinimumProtocolVersion":       "TLSv1.2_2021",
+							"CloudfrontDefaultCertificate": false,
+						},
+					},
+				},
+				"aws:cloudfront_distribution:cdn -> aws:acm_certificate:cert",
+			},
+			render: "aws:cloudfront_distribution:cdn",
+			contains: []string{
+				`acmCertificateArn: cert.arn,`,
+				`sslSupportMethod: "sni-only",`,
+			},
+		},
+		{
+			name: "rds read replica",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_read_replica", Name: "db-replica"},
+					Properties: construct.Properties{
+						"SourceInstance": construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+						"InstanceClass":  "db.t3.micro",
+					},
+				},
+				"aws:rds_read_replica:db-replica -> aws:rds_instance:db",
+			},
+			render: "aws:rds_read_replica:db-replica",
+			contains: []string{
+				`replicateSourceDb: db.identifier,`,
+				`vpcSecurityGroupIds: db.vpcSecurityGroupIds,`,
+			},
+		},
+		{
+			name: "arm64 lambda function",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "fn-ExecutionRole"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+					Properties: construct.Properties{
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "fn-ExecutionRole"},
+						"Image":         "example.com/fn:latest",
+						"Architecture":  "arm64",
+					},
+				},
+				"aws:lambda_function:fn -> aws:iam_role:fn-ExecutionRole",
+			},
+			render: "aws:lambda_function:fn",
+			contains: []string{
+				`architectures: ["arm64"],`,
+			},
+		},
+		{
+			name: "http api lambda integration",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "http_api", Name: "api"},
+					Properties: construct.Properties{"ProtocolType": "HTTP"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "fn-ExecutionRole"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+					Properties: construct.Properties{
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "fn-ExecutionRole"},
+						"Image":         "example.com/fn:latest",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "http_api_integration", Namespace: "api", Name: "integ"},
+					Properties: construct.Properties{
+						"Api":             construct.ResourceId{Provider: "aws", Type: "http_api", Name: "api"},
+						"RouteKey":        "GET /items/{id}",
+						"IntegrationType": "AWS_PROXY",
+						"IntegrationUri": construct.PropertyRef{
+							Resource: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+							Property: "LambdaIntegrationUri",
+						},
+						"PayloadFormatVersion": "2.0",
+					},
+				},
+				"aws:lambda_function:fn -> aws:iam_role:fn-ExecutionRole",
+				"aws:http_api_integration:api:integ -> aws:http_api:api",
+				"aws:http_api_integration:api:integ -> aws:lambda_function:fn",
+			},
+			render: "aws:http_api_integration:api:integ",
+			contains: []string{
+				`new aws.apigatewayv2.Integration("integ", {`,
+				`apiId: api.id,`,
+				`integrationUri: fn.invokeArn,`,
+				`routeKey: "GET /items/{id}",`,
+			},
+		},
+		{
+			name: "s3 bucket versioning and lifecycle rules",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"ForceDestroy": true,
+						"Versioning":   true,
+						"LifecycleRules": []any{
+							map[string]any{
+								"Prefix":         "logs/",
+								"TransitionDays": 30,
+								"StorageClass":   "GLACIER",
+								"ExpirationDays": 365,
+							},
+						},
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`versioning: {`,
+				`lifecycleRules: [{expirationDays: 365, prefix: "logs/", storageClass: "GLACIER", transitionDays: 30}].map(`,
+				`? [{ days: rule.transitionDays, storageClass: rule.storageClass! }]`,
+			},
+		},
+		{
+			name: "cloudfront behavior with custom origin request policy",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudfront_origin_request_policy", Name: "forward-auth"},
+					Properties: construct.Properties{
+						"HeaderBehavior":      "whitelist",
+						"Headers":             []any{"Authorization"},
+						"CookieBehavior":      "none",
+						"QueryStringBehavior": "all",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudfront_distribution", Name: "cdn"},
+					Properties: construct.Properties{
+						"DefaultCacheBehavior": map[string]any{"TargetOriginId": "site"},
+						"CacheBehaviors": []any{
+							map[string]any{
+								"PathPattern":    "/api/*",
+								"TargetOriginId": "api",
+								"OriginRequestPolicyId": construct.PropertyRef{
+									Resource: construct.ResourceId{Provider: "aws", Type: "cloudfront_origin_request_policy", Name: "forward-auth"},
+									Property: "Id",
+								},
+							},
+						},
+					},
+				},
+				"aws:cloudfront_distribution:cdn -> aws:cloudfront_origin_request_policy:forward-auth",
+			},
+			render: "aws:cloudfront_distribution:cdn",
+			contains: []string{
+				`orderedCacheBehaviors: [{originRequestPolicyId: forward_auth.id, pathPattern: "/api/*", targetOriginId: "api"}],`,
+			},
+		},
+		{
+			name: "cloudfront origin request policy",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudfront_origin_request_policy", Name: "forward-auth"},
+					Properties: construct.Properties{
+						"HeaderBehavior":      "whitelist",
+						"Headers":             []any{"Authorization"},
+						"CookieBehavior":      "none",
+						"QueryStringBehavior": "all",
+					},
+				},
+			},
+			render: "aws:cloudfront_origin_request_policy:forward-auth",
+			contains: []string{
+				`headerBehavior: "whitelist",`,
+				`headers: { items: ["Authorization"] },`,
+				`cookieBehavior: "none",`,
+				`queryStringBehavior: "all",`,
+			},
+		},
+		{
+			name: "api integration through a network load balancer",
+			graph: []any{
+				&construct.Resource{ID: construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"}},
+				&construct.Resource{ID: construct.ResourceId{Provider: "aws", Type: "api_method", Namespace: "api", Name: "method"}},
+				&construct.Resource{ID: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "nlb"}},
+				&construct.Resource{ID: construct.ResourceId{Provider: "aws", Type: "vpc_link", Name: "link"}},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_integration", Namespace: "api", Name: "integ"},
+					Properties: construct.Properties{
+						"RestApi":               construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+						"Method":                construct.ResourceId{Provider: "aws", Type: "api_method", Namespace: "api", Name: "method"},
+						"VpcLink":               construct.ResourceId{Provider: "aws", Type: "vpc_link", Name: "link"},
+						"Target":                construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "nlb"},
+						"Route":                 "/users/{id+}",
+						"Type":                  "HTTP_PROXY",
+						"ConnectionType":        "VPC_LINK",
+						"IntegrationHttpMethod": "ANY",
+						"Uri": construct.PropertyRef{
+							Resource: construct.ResourceId{Provider: "aws", Type: "api_integration", Namespace: "api", Name: "integ"},
+							Property: "LbUri",
+						},
+					},
+				},
+			},
+			render: "aws:api_integration:api:integ",
+			contains: []string{
+				`connectionId: link.id,`,
+				"uri: pulumi.interpolate`http://${",
+				`(nlb as aws.lb.LoadBalancer).dnsName`,
+				// greedy path parameters ({id+}) are passed to the load balancer as regular ones ({id})
+				"}${\"/users/{id+}\".replace(/\\+}/g, '}')}`,",
+			},
+		},
+		{
+			name: "sns topic encryption and retry policy",
+			graph: []any{
+				&construct.Resource{ID: construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "topic-key"}},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "sns_topic", Name: "events"},
+					Properties: construct.Properties{
+						"KmsKey": construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "topic-key"},
+						"HttpRetryPolicy": map[string]any{
+							"MinDelayTarget":  5,
+							"MaxDelayTarget":  120,
+							"NumRetries":      10,
+							"BackoffFunction": "exponential",
+						},
+					},
+				},
+			},
+			render: "aws:sns_topic:events",
+			contains: []string{
+				`kmsMasterKeyId: topic_key.arn,`,
+				"deliveryPolicy: JSON.stringify({\n            http: { defaultHealthyRetryPolicy: " +
+					`{backoffFunction: "exponential", maxDelayTarget: 120, minDelayTarget: 5, numRetries: 10} },`,
+			},
+		},
+		{
+			name: "vpc with ipv6 enabled",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+					Properties: construct.Properties{
+						"CidrBlock":                    "10.0.0.0/16",
+						"EnableDnsHostnames":           true,
+						"EnableDnsSupport":             true,
+						"AssignGeneratedIpv6CidrBlock": true,
+					},
+				},
+			},
+			render: "aws:vpc:vpc",
+			contains: []string{
+				`assignGeneratedIpv6CidrBlock: true`,
+			},
+		},
+		{
+			name: "subnet with ipv6",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "private0"},
+					Properties: construct.Properties{
+						"Vpc":                         construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"CidrBlock":                   "10.0.128.0/18",
+						"AssignIpv6AddressOnCreation": true,
+						"Ipv6CidrBlockIndex":          2,
+					},
+				},
+			},
+			render: "aws:subnet:vpc:private0",
+			contains: []string{
+				`ipv6CidrBlock: vpc.ipv6CidrBlock.apply((cidr) => {`,
+				`(parseInt(hextets[3], 16) + 2).toString(16)`,
+				`assignIpv6AddressOnCreation: true,`,
+			},
+		},
+		{
+			name: "vpc with dns disabled",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+					Properties: construct.Properties{
+						"CidrBlock":          "10.0.0.0/16",
+						"EnableDnsHostnames": false,
+						"EnableDnsSupport":   false,
+					},
+				},
+			},
+			render: "aws:vpc:vpc",
+			contains: []string{
+				`enableDnsHostnames: false,`,
+				`enableDnsSupport: false,`,
+			},
+		},
+		{
+			name: "private route table with egress-only gateway",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "egress_only_internet_gateway", Namespace: "vpc", Name: "eigw"},
+					Properties: construct.Properties{"Vpc": construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"}},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "nat_gateway", Name: "nat"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route_table", Namespace: "vpc", Name: "private"},
+					Properties: construct.Properties{
+						"Vpc": construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"Routes": []any{
+							map[string]any{
+								"CidrBlock":  "0.0.0.0/0",
+								"NatGateway": construct.ResourceId{Provider: "aws", Type: "nat_gateway", Name: "nat"},
+							},
+							map[string]any{
+								"Ipv6CidrBlock":     "::/0",
+								"EgressOnlyGateway": construct.ResourceId{Provider: "aws", Type: "egress_only_internet_gateway", Namespace: "vpc", Name: "eigw"},
+							},
+						},
+					},
+				},
+			},
+			render: "aws:route_table:vpc:private",
+			contains: []string{
+				`natGatewayId: nat.id`,
+				`ipv6CidrBlock: "::/0"`,
+				`egressOnlyGatewayId: eigw.id`,
+			},
+		},
+		{
+			name: "private route table through nat instance",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "ec2_instance", Name: "nat"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "route_table", Namespace: "vpc", Name: "private"},
+					Properties: construct.Properties{
+						"Vpc": construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"Routes": []any{
+							map[string]any{
+								"CidrBlock": "0.0.0.0/0",
+								"Instance":  construct.ResourceId{Provider: "aws", Type: "ec2_instance", Name: "nat"},
+							},
+						},
+					},
+				},
+			},
+			render: "aws:route_table:vpc:private",
+			contains: []string{
+				`networkInterfaceId: nat.primaryNetworkInterfaceId`,
+			},
+		},
+		{
+			name: "nat instance",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "public"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "ec2_instance", Name: "nat"},
+					Properties: construct.Properties{
+						"Subnet":          construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "public"},
+						"InstanceType":    "t3.nano",
+						"AmiSsmParameter": "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-x86_64",
+						"NatInstance":     true,
+					},
+				},
+			},
+			render: "aws:ec2_instance:nat",
+			contains: []string{
+				`ami: aws.ssm.getParameterOutput({ name: "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-x86_64" }).value`,
+				`sourceDestCheck: false`,
+				`iptables -t nat -A POSTROUTING`,
+			},
+		},
+		{
+			name: "egress-only internet gateway",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "egress_only_internet_gateway", Namespace: "vpc", Name: "eigw"},
+					Properties: construct.Properties{"Vpc": construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"}},
+				},
+			},
+			render: "aws:egress_only_internet_gateway:vpc:eigw",
+			contains: []string{
+				`new aws.ec2.EgressOnlyInternetGateway("eigw"`,
+				`vpcId: vpc.id`,
+			},
+		},
+		{
+			name: "api method settings throttling",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_stage", Name: "stage"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_method_settings", Namespace: "stage", Name: "items-get"},
+					Properties: construct.Properties{
+						"Stage":                construct.ResourceId{Provider: "aws", Type: "api_stage", Name: "stage"},
+						"MethodPath":           "items/GET",
+						"ThrottlingRateLimit":  100.0,
+						"ThrottlingBurstLimit": 50,
+					},
+				},
+			},
+			render: "aws:api_method_settings:stage:items-get",
+			contains: []string{
+				`restApi: stage.restApi`,
+				`stageName: stage.stageName`,
+				`methodPath: "items/GET"`,
+				`throttlingRateLimit: 100`,
+				`throttlingBurstLimit: 50`,
+			},
+		},
+		{
+			name: "multi-az rds instance",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "security_group", Name: "sg"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "prod-db"},
+					Properties: construct.Properties{
+						"SubnetGroup":      construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+						"SecurityGroups":   []any{construct.ResourceId{Provider: "aws", Type: "security_group", Name: "sg"}},
+						"DatabaseName":     "main",
+						"Engine":           "postgres",
+						"EngineVersion":    "14.11",
+						"InstanceClass":    "db.t3.micro",
+						"AllocatedStorage": 20,
+						"MultiAz":          true,
+					},
+				},
+			},
+			render: "aws:rds_instance:prod-db",
+			contains: []string{
+				`multiAz: true`,
+			},
+		},
+		{
+			name: "s3 bucket owner enforced",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"ForceDestroy":    true,
+						"ObjectOwnership": "BucketOwnerEnforced",
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`new aws.s3.BucketOwnershipControls(`,
+				`objectOwnership: "BucketOwnerEnforced"`,
+			},
+		},
+		{
+			name: "lambda function with code signing config",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "code_signing_config", Name: "signing"},
+					Properties: construct.Properties{
+						"SigningProfileVersionArns":     []any{"arn:aws:signer:us-east-1:123456789012:/signing-profiles/app/abc"},
+						"UntrustedArtifactOnDeployment": "Enforce",
+					},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+					Properties: construct.Properties{
+						"ExecutionRole":     construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+						"Image":             "image:latest",
+						"CodeSigningConfig": construct.ResourceId{Provider: "aws", Type: "code_signing_config", Name: "signing"},
+					},
+				},
+			},
+			render: "aws:lambda_function:fn",
+			contains: []string{
+				`codeSigningConfigArn: signing.arn`,
+			},
+		},
+		{
+			name: "code signing config",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "code_signing_config", Name: "signing"},
+					Properties: construct.Properties{
+						"SigningProfileVersionArns":     []any{"arn:aws:signer:us-east-1:123456789012:/signing-profiles/app/abc"},
+						"UntrustedArtifactOnDeployment": "Enforce",
+					},
+				},
+			},
+			render: "aws:code_signing_config:signing",
+			contains: []string{
+				`signingProfileVersionArns: ["arn:aws:signer:us-east-1:123456789012:/signing-profiles/app/abc"]`,
+				`untrustedArtifactOnDeployment: "Enforce"`,
+			},
+		},
+		{
+			name: "elasticache cluster custom node type and count",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "log_group", Name: "logs"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "elasticache_subnet_group", Name: "subnets"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "security_group", Name: "sg"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "elasticache_cluster", Name: "cache"},
+					Properties: construct.Properties{
+						"Engine":          "memcached",
+						"NodeType":        "cache.r6g.large",
+						"NumCacheNodes":   3,
+						"CloudwatchGroup": construct.ResourceId{Provider: "aws", Type: "log_group", Name: "logs"},
+						"SubnetGroup":     construct.ResourceId{Provider: "aws", Type: "elasticache_subnet_group", Name: "subnets"},
+						"SecurityGroups":  []any{construct.ResourceId{Provider: "aws", Type: "security_group", Name: "sg"}},
+					},
+				},
+			},
+			render: "aws:elasticache_cluster:cache",
+			contains: []string{
+				`nodeType: "cache.r6g.large"`,
+				`numCacheNodes: 3`,
+			},
+		},
+		{
+			name: "target group deregistration delay and slow start",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "target_group", Name: "tg"},
+					Properties: construct.Properties{
+						"Port":                80,
+						"Protocol":            "HTTP",
+						"TargetType":          "ip",
+						"Vpc":                 construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"DeregistrationDelay": 30,
+						"SlowStart":           60,
+					},
+				},
+			},
+			render: "aws:target_group:tg",
+			contains: []string{
+				`deregistrationDelay: 30`,
+				`slowStart: 60`,
+			},
+		},
+		{
+			name: "network load balancer target group tcp health check",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "target_group", Name: "tg"},
+					Properties: construct.Properties{
+						"Port":       8080,
+						"Protocol":   "TCP",
+						"TargetType": "ip",
+						"Vpc":        construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"HealthCheck": map[string]any{
+							"Enabled":            true,
+							"Protocol":           "TCP",
+							"Interval":           10,
+							"HealthyThreshold":   3,
+							"UnhealthyThreshold": 3,
+						},
+						"DeregistrationDelay":   30,
+						"ConnectionTermination": true,
+					},
+				},
+			},
+			render: "aws:target_group:tg",
+			contains: []string{
+				"interval: 10,\n    protocol: \"TCP\",",
+				`connectionTermination: true`,
+			},
+		},
+		{
+			name: "kinesis stream shard count",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "kinesis_stream", Name: "events"},
+					Properties: construct.Properties{
+						"StreamMode":      "PROVISIONED",
+						"ShardCount":      4,
+						"RetentionPeriod": 48,
+					},
+				},
+			},
+			render: "aws:kinesis_stream:events",
+			contains: []string{
+				`streamMode: "PROVISIONED"`,
+				`shardCount: 4`,
+				`retentionPeriod: 48`,
+			},
+		},
+		{
+			name: "api stage canary deployment",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_deployment", Name: "deployment"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_stage", Namespace: "api", Name: "stage"},
+					Properties: construct.Properties{
+						"RestApi":    construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+						"Deployment": construct.ResourceId{Provider: "aws", Type: "api_deployment", Name: "deployment"},
+						"StageName":  "prod",
+						"CanarySettings": map[string]any{
+							"PercentTraffic":         10.0,
+							"StageVariableOverrides": map[string]any{"version": "v2"},
+						},
+					},
+				},
+			},
+			render: "aws:api_stage:api:stage",
+			contains: []string{
+				`deploymentId: deployment.id`,
+				`percentTraffic: 10,`,
+				`stageVariableOverrides: {version: "v2"}`,
+			},
+		},
+		{
+			name: "eks node group ignores desired size changes",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "node-role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_node_group", Name: "nodes"},
+					Properties: construct.Properties{
+						"Cluster":        construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+						"NodeRole":       construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "node-role"},
+						"Subnets":        []any{},
+						"DesiredSize":    2,
+						"MinSize":        1,
+						"MaxSize":        3,
+						"MaxUnavailable": 1,
+						"DiskSize":       20,
+						"InstanceTypes":  []any{"t3.medium"},
+					},
+				},
+			},
+			render: "aws:eks_node_group:nodes",
+			contains: []string{
+				`{ ignoreChanges: ["scalingConfig.desiredSize"] }`,
+			},
+		},
+		{
+			name: "eks node group max unavailable percentage",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "node-role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_node_group", Name: "nodes"},
+					Properties: construct.Properties{
+						"Cluster":                  construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+						"NodeRole":                 construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "node-role"},
+						"Subnets":                  []any{},
+						"DesiredSize":              4,
+						"MinSize":                  2,
+						"MaxSize":                  8,
+						"MaxUnavailable":           1,
+						"MaxUnavailablePercentage": 25,
+						"DiskSize":                 20,
+						"InstanceTypes":            []any{"t3.medium"},
+					},
+				},
+			},
+			render: "aws:eks_node_group:nodes",
+			contains: []string{
+				"updateConfig: {\n                maxUnavailablePercentage: 25,\n            },",
+			},
+		},
+		{
+			name: "security group restricted egress",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "security_group", Namespace: "vpc", Name: "sg"},
+					Properties: construct.Properties{
+						"Vpc":            construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"RestrictEgress": true,
+						"EgressRules": []any{
+							map[string]any{
+								"CidrBlocks": []any{"0.0.0.0/0"},
+								"FromPort":   443,
+								"Protocol":   "tcp",
+								"ToPort":     443,
+							},
+						},
+					},
+				},
+			},
+			render: "aws:security_group:vpc:sg",
+			contains: []string{
+				`egress: [{cidrBlocks: ["0.0.0.0/0"], fromPort: 443, protocol: "tcp", toPort: 443}]`,
+			},
+		},
+		{
+			name: "iam role assumes external role",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+					Properties: construct.Properties{
+						"AssumeRolePolicyDoc": map[string]any{"Version": "2012-10-17"},
+						"ExternalRoleArns":    []any{"arn:aws:iam::210987654321:role/shared-data"},
+					},
+				},
+			},
+			render: "aws:iam_role:role",
+			contains: []string{
+				"name: `${\"role\"}-assume-external-roles`",
+				`Action: ['sts:AssumeRole'],`,
+				`Resource: ["arn:aws:iam::210987654321:role/shared-data"],`,
+			},
+		},
+		{
+			name: "efs access point posix user and root directory",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "efs_file_system", Name: "fs"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "efs_access_point", Namespace: "fs", Name: "ap"},
+					Properties: construct.Properties{
+						"FileSystem": construct.ResourceId{Provider: "aws", Type: "efs_file_system", Name: "fs"},
+						"PosixUser": map[string]any{
+							"Uid":           2000,
+							"Gid":           3000,
+							"SecondaryGids": []any{4000},
+						},
+						"RootDirectory": map[string]any{
+							"Path": "/data",
+							"CreationInfo": map[string]any{
+								"OwnerUid":    2000,
+								"OwnerGid":    3000,
+								"Permissions": "750",
+							},
+						},
+					},
+				},
+			},
+			render: "aws:efs_access_point:fs:ap",
+			contains: []string{
+				`fileSystemId: fs.id`,
+				`posixUser: {gid: 3000, secondaryGids: [4000], uid: 2000}`,
+				`rootDirectory: {creationInfo: {ownerGid: 3000, ownerUid: 2000, permissions: "750"}, path: "/data"}`,
+			},
+		},
+		{
+			name: "rds parameter group with custom parameter",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_parameter_group", Name: "params"},
+					Properties: construct.Properties{
+						"Family": "postgres14",
+						"Parameters": []any{
+							map[string]any{"Name": "max_connections", "Value": "200", "ApplyMethod": "pending-reboot"},
+						},
+					},
+				},
+			},
+			render: "aws:rds_parameter_group:params",
+			contains: []string{
+				`family: "postgres14"`,
+				`parameters: [{applyMethod: "pending-reboot", name: "max_connections", value: "200"}]`,
+			},
+		},
+		{
+			name: "rds instance references parameter group",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_parameter_group", Name: "params"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rds_instance", Name: "db"},
+					Properties: construct.Properties{
+						"SubnetGroup":      construct.ResourceId{Provider: "aws", Type: "rds_subnet_group", Name: "subnets"},
+						"ParameterGroup":   construct.ResourceId{Provider: "aws", Type: "rds_parameter_group", Name: "params"},
+						"SecurityGroups":   []any{},
+						"DatabaseName":     "main",
+						"Engine":           "postgres",
+						"EngineVersion":    "14.11",
+						"InstanceClass":    "db.t3.micro",
+						"AllocatedStorage": 20,
+					},
+				},
+			},
+			render: "aws:rds_instance:db",
+			contains: []string{
+				`parameterGroupName: params.name`,
+			},
+		},
+		{
+			name: "event rule on custom bus",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "event_bus", Name: "orders"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "event_rule", Name: "created"},
+					Properties: construct.Properties{
+						"EventBus":     construct.ResourceId{Provider: "aws", Type: "event_bus", Name: "orders"},
+						"EventPattern": `{"detail-type":["OrderCreated"]}`,
+						"State":        "ENABLED",
+					},
+				},
+			},
+			render: "aws:event_rule:created",
+			contains: []string{
+				`eventBusName: orders.name`,
+				`state: "ENABLED"`,
+			},
+		},
+		{
+			name: "ecr repo scan on push disabled",
+			graph: []any{
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "ecr_repo", Name: "repo"},
+					Properties: construct.Properties{"ScanOnPush": false},
+				},
+			},
+			render: "aws:ecr_repo:repo",
+			contains: []string{
+				`scanOnPush: false`,
+			},
+		},
+		{
+			name: "dynamodb read capacity scaling target",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "app_autoscaling_target", Name: "table-read"},
+					Properties: construct.Properties{
+						"Table":             construct.ResourceId{Provider: "aws", Type: "dynamodb_table", Name: "table"},
+						"ScalableDimension": "dynamodb:table:ReadCapacityUnits",
+						"MinCapacity":       5,
+						"MaxCapacity":       50,
+					},
+				},
+			},
+			render: "aws:app_autoscaling_target:table-read",
+			contains: []string{
+				"resourceId: pulumi.interpolate`table/${table.name}`",
+				`scalableDimension: "dynamodb:table:ReadCapacityUnits"`,
+				`minCapacity: 5`,
+				`maxCapacity: 50`,
+			},
+		},
+		{
+			name: "resource id comment precedes lambda",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "handler"},
+					Properties: construct.Properties{
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+					},
+				},
+			},
+			render: "aws:lambda_function:handler",
+			contains: []string{
+				"// aws:lambda_function:handler\nconst handler = new aws.lambda.Function(",
+			},
+		},
+		{
+			name: "fargate profile custom selectors",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "pod-role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_fargate_profile", Name: "profile"},
+					Properties: construct.Properties{
+						"Cluster":          construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+						"PodExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "pod-role"},
+						"Subnets":          []any{},
+						"Selectors": []any{
+							map[string]any{
+								"Namespace": "payments",
+								"Labels":    map[string]any{"tier": "batch"},
+							},
+							map[string]any{"Namespace": "jobs"},
+						},
+					},
+				},
+			},
+			render: "aws:eks_fargate_profile:profile",
+			contains: []string{
+				`namespace: "payments",`,
+				`"tier": "batch",`,
+				`namespace: "jobs",`,
+			},
+		},
+		{
+			name: "s3 bucket cors rule",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"ForceDestroy": true,
+						"CorsRules": []any{
+							map[string]any{
+								"AllowedOrigins": []any{"https://example.com"},
+								"AllowedMethods": []any{"GET", "HEAD"},
+								"MaxAgeSeconds":  3000,
+							},
+						},
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`corsRules: [{`,
+				`allowedOrigins: ["https://example.com"]`,
+				`allowedMethods: ["GET", "HEAD"]`,
+				`maxAgeSeconds: 3000`,
+			},
+		},
+		{
+			name: "s3 bucket transfer acceleration",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"ForceDestroy":         true,
+						"TransferAcceleration": true,
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`accelerationStatus: 'Enabled'`,
+			},
+		},
+		{
+			name: "eks cluster secrets encryption",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "cluster-role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "secrets-key"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "eks_cluster", Name: "cluster"},
+					Properties: construct.Properties{
+						"ClusterRole":          construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "cluster-role"},
+						"SecretsEncryptionKey": construct.ResourceId{Provider: "aws", Type: "kms_key", Name: "secrets-key"},
+						"Subnets":              []any{},
+						"Version":              "1.28",
+					},
+				},
+			},
+			render: "aws:eks_cluster:cluster",
+			contains: []string{
+				`keyArn: secrets_key.arn,`,
+				`resources: ['secrets'],`,
+			},
+		},
+		{
+			name: "lambda image command override",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "handler"},
+					Properties: construct.Properties{
+						"ExecutionRole": construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+						"Image":         "123456789012.dkr.ecr.us-east-1.amazonaws.com/handler:latest",
+						"ImageConfig": map[string]any{
+							"Command":          []any{"app.handler"},
+							"WorkingDirectory": "/var/task",
+						},
+					},
+				},
+			},
+			render: "aws:lambda_function:handler",
+			contains: []string{
+				`packageType: 'Image',`,
+				`commands: ["app.handler"],`,
+				`workingDirectory: "/var/task",`,
+			},
+		},
+		{
+			name: "cloudfront spa root object and error response",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "cloudfront_distribution", Name: "site"},
+					Properties: construct.Properties{
+						"DefaultRootObject":    "index.html",
+						"DefaultCacheBehavior": map[string]any{"TargetOriginId": "bucket"},
+						"CustomErrorResponses": []any{
+							map[string]any{
+								"ErrorCode":        404,
+								"ResponseCode":     200,
+								"ResponsePagePath": "/index.html",
+							},
+						},
+					},
+				},
+			},
+			render: "aws:cloudfront_distribution:site",
+			contains: []string{
+				`defaultRootObject: "index.html",`,
+				`customErrorResponses: [{errorCode: 404, responseCode: 200, responsePagePath: "/index.html"}],`,
+			},
+		},
+		{
+			name: "api stage access logs",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_deployment", Name: "deployment"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "log_group", Name: "access-logs"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "api_stage", Name: "stage"},
+					Properties: construct.Properties{
+						"RestApi":        construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+						"Deployment":     construct.ResourceId{Provider: "aws", Type: "api_deployment", Name: "deployment"},
+						"StageName":      "stage",
+						"AccessLogGroup": construct.ResourceId{Provider: "aws", Type: "log_group", Name: "access-logs"},
+					},
+				},
+			},
+			render: "aws:api_stage:stage",
+			contains: []string{
+				`destinationArn: access_logs.arn,`,
+				`requestId: '$context.requestId',`,
+			},
+		},
+		{
+			name: "load balancer access logs archived to s3",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "lb-logs"},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "load_balancer", Name: "lb"},
+					Properties: construct.Properties{
+						"Scheme":           "internal",
+						"Type":             "application",
+						"Subnets":          []any{},
+						"AccessLogsBucket": construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "lb-logs"},
+						"AccessLogsPrefix": "lb",
+					},
+				},
+			},
+			render: "aws:load_balancer:lb",
+			contains: []string{
+				`bucket: lb_logs.bucket,`,
+				`prefix: "lb",`,
+				`enabled: true,`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+			tc := newTestCompiler(t, g)
+
+			var rid construct.ResourceId
+			require.NoError(rid.Parse(tt.render))
+
+			buf := new(bytes.Buffer)
+			require.NoError(tc.RenderResource(buf, rid))
+			for _, want := range tt.contains {
+				assert.Contains(buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestRenderResource_EnvironmentVariableOrder(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	names := []string{"ZETA", "ALPHA", "MU", "BETA", "OMEGA", "DELTA", "KAPPA", "GAMMA", "EPSILON", "THETA"}
+	env := make(map[string]any, len(names))
+	for _, name := range names {
+		env[name] = strings.ToLower(name)
+	}
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{
+			ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+			Properties: construct.Properties{
+				"Image":                "image",
+				"ExecutionRole":        construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "role"},
+				"EnvironmentVariables": env,
+			},
+		},
+		"aws:iam_role:role",
+	)
+	fn := construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"}
+
+	// Map iteration order is randomized, so rendering repeatedly would catch any unsorted output
+	var first string
+	for i := 0; i < 20; i++ {
+		buf := new(bytes.Buffer)
+		require.NoError(newTestCompiler(t, g).RenderResource(buf, fn))
+		if i == 0 {
+			first = buf.String()
+			continue
+		}
+		require.Equal(first, buf.String(), "render %d differs from the first", i)
+	}
+
+	sort.Strings(names)
+	entries := make([]string, len(names))
+	for i, name := range names {
+		entries[i] = fmt.Sprintf("%s: %q", name, strings.ToLower(name))
+	}
+	assert.Contains(first, "variables: {"+strings.Join(entries, ", ")+"}")
+}
+
+// testKB loads the standard knowledge base once for all the tests which compile resources.
+var testKB = sync.OnceValues(func() (*knowledgebase.KnowledgeBase, error) {
+	return reader.NewKBFromFs(templates.ResourceTemplates, templates.EdgeTemplates, templates.Models)
+})
+
+// newTestCompiler creates a [TemplatesCompiler] for the graph using the standard templates.
+func newTestCompiler(t *testing.T, g construct.Graph) *TemplatesCompiler {
+	t.Helper()
+	templatesFS, err := fs.Sub(standardTemplates, "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kb, err := testKB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := &TemplatesCompiler{
+		graph:     g,
+		kb:        kb,
+		templates: &templateStore{fs: templatesFS},
+	}
+	tc.vars, err = VariablesFromGraph(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tc
+}
@@ -0,0 +1,96 @@
+package iac
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"gopkg.in/yaml.v3"
+)
+
+// StackReference marks an imported resource as being managed by another Pulumi stack. Instead of using the values
+// in the resource's properties, the properties are read from the other stack's outputs when this stack is deployed.
+type StackReference struct {
+	// Stack is the fully qualified name of the stack which manages the resource (`organization/project/stack`).
+	Stack string `yaml:"stack"`
+
+	// Outputs maps each of the resource's properties to the name of the stack output which holds its value.
+	Outputs map[string]string `yaml:"outputs"`
+}
+
+// ReadStackReferences reads the stack references of imported resources from YAML, keyed by resource ID. For example:
+//
+//	aws:subnet:vpc:shared-subnet:
+//	  stack: acme/network/dev
+//	  outputs:
+//	    Id: sharedSubnetId
+func ReadStackReferences(r io.Reader) (map[construct.ResourceId]StackReference, error) {
+	var refs map[construct.ResourceId]StackReference
+	if err := yaml.NewDecoder(r).Decode(&refs); err != nil {
+		return nil, err
+	}
+	var errs error
+	for id, ref := range refs {
+		if ref.Stack == "" {
+			errs = errors.Join(errs, fmt.Errorf("stack reference for %s is missing the stack", id))
+		}
+		if len(ref.Outputs) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("stack reference for %s has no outputs", id))
+		}
+	}
+	return refs, errs
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-zA-Z0-9_$]+`)
+
+func stackReferenceVar(stack string) string {
+	return "$stack_" + nonIdentifierChars.ReplaceAllString(stack, "_")
+}
+
+// renderStackReferences declares a pulumi.StackReference for each of the stacks referenced by an imported resource.
+func (tc *TemplatesCompiler) renderStackReferences(out io.Writer) error {
+	stacks := make(map[string]struct{})
+	for id, ref := range tc.stackReferences {
+		r, err := tc.graph.Vertex(id)
+		if err != nil || !r.Imported {
+			continue
+		}
+		stacks[ref.Stack] = struct{}{}
+	}
+	if len(stacks) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(stacks))
+	for stack := range stacks {
+		names = append(names, stack)
+	}
+	sort.Strings(names)
+
+	// inside a component, the references are created by (and parented to) the component like its other resources
+	opts := ""
+	if tc.inComponent {
+		opts = ", undefined, " + componentParent
+	}
+	for _, stack := range names {
+		_, err := fmt.Fprintf(out, "const %s = new pulumi.StackReference(%s%s)\n", stackReferenceVar(stack), templateString(stack), opts)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(out)
+	return err
+}
+
+// stackReferenceInputs replaces the inputs of an imported resource managed by another stack with that stack's outputs.
+func (tc *TemplatesCompiler) stackReferenceInputs(r *construct.Resource, inputs templateInputArgs) {
+	ref, ok := tc.stackReferences[r.ID]
+	if !ok || !r.Imported {
+		return
+	}
+	for property, output := range ref.Outputs {
+		inputs[property] = fmt.Sprintf("%s.getOutput(%s)", stackReferenceVar(ref.Stack), templateString(output))
+	}
+}
@@ -0,0 +1,102 @@
+package iac
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	engine "github.com/klothoplatform/klotho/pkg/engine"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/reader"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderStackReferences(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	subnet := construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "shared-subnet"}
+	g := graphtest.MakeGraph(t, construct.NewGraph(), &construct.Resource{
+		ID:         subnet,
+		Properties: construct.Properties{"Id": "subnet-0123456789"},
+		Imported:   true,
+	})
+	tc := newTestCompiler(t, g)
+	tc.stackReferences = map[construct.ResourceId]StackReference{
+		subnet: {Stack: "acme/network/dev", Outputs: map[string]string{"Id": "sharedSubnetId"}},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(tc.renderStackReferences(buf))
+	assert.Equal("const $stack_acme_network_dev = new pulumi.StackReference(\"acme/network/dev\")\n\n", buf.String())
+
+	buf.Reset()
+	require.NoError(tc.RenderResource(buf, subnet))
+	assert.Contains(buf.String(), `aws.ec2.Subnet.get("shared-subnet", $stack_acme_network_dev.getOutput("sharedSubnetId"))`)
+	assert.NotContains(buf.String(), "subnet-0123456789")
+}
+
+func TestRenderStackReferences_NotImported(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	subnet := construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "subnet"}
+	g := graphtest.MakeGraph(t, construct.NewGraph(), subnet)
+	tc := newTestCompiler(t, g)
+	tc.stackReferences = map[construct.ResourceId]StackReference{
+		subnet: {Stack: "acme/network/dev", Outputs: map[string]string{"Id": "subnetId"}},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(tc.renderStackReferences(buf))
+	assert.Empty(buf.String(), "only imported resources are read from other stacks")
+}
+
+func TestTranslate_StackReferencesFromConfig(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	refs, err := ReadStackReferences(strings.NewReader(`
+aws:subnet:vpc:shared-subnet:
+  stack: acme/network/dev
+  outputs:
+    Id: sharedSubnetId
+`))
+	require.NoError(err)
+
+	kb, err := reader.NewKBFromFs(templates.ResourceTemplates, templates.EdgeTemplates, templates.Models)
+	require.NoError(err)
+	sol := engine.NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+	require.NoError(sol.LoadGraph(graphtest.MakeGraph(t, construct.NewGraph(), &construct.Resource{
+		ID:         construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "shared-subnet"},
+		Properties: construct.Properties{"Id": "subnet-0123456789"},
+		Imported:   true,
+	})))
+
+	plugin := Plugin{Config: &PulumiConfig{AppName: "test"}, KB: kb, StackReferences: refs}
+	files, err := plugin.Translate(sol)
+	require.NoError(err)
+
+	var index string
+	for _, f := range files {
+		if f.Path() == "index.ts" {
+			buf := new(bytes.Buffer)
+			_, err := f.WriteTo(buf)
+			require.NoError(err)
+			index = buf.String()
+		}
+	}
+	assert.Contains(index, `const $stack_acme_network_dev = new pulumi.StackReference("acme/network/dev")`)
+	assert.Contains(index, `$stack_acme_network_dev.getOutput("sharedSubnetId")`)
+}
+
+func TestReadStackReferences_Invalid(t *testing.T) {
+	_, err := ReadStackReferences(strings.NewReader(`
+aws:subnet:vpc:shared-subnet:
+  outputs: {}
+`))
+	assert.ErrorContains(t, err, "stack reference for aws:subnet:vpc:shared-subnet is missing the stack")
+	assert.ErrorContains(t, err, "stack reference for aws:subnet:vpc:shared-subnet has no outputs")
+}
@@ -9,6 +9,7 @@ import (
 
 	construct "github.com/klothoplatform/klotho/pkg/construct"
 	kio "github.com/klothoplatform/klotho/pkg/io"
+	knowledgebase "github.com/klothoplatform/klotho/pkg/knowledgebase"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +18,12 @@ type TemplatesCompiler struct {
 
 	graph construct.Graph
 	vars  variables
+	kb    knowledgebase.TemplateKB
+
+	// inComponent is set while rendering resources inside a component's constructor
+	inComponent bool
+
+	stackReferences map[construct.ResourceId]StackReference
 }
 
 // globalVariables are variables set in the global template and available to all resources
@@ -44,5 +44,7 @@ function create(args: Args): aws.acm.Certificate {
 function properties(object: aws.acm.Certificate, args: Args) {
     return {
         Arn: object.arn,
+        ValidationRecordName: object.domainValidationOptions[0].resourceRecordName,
+        ValidationRecordValue: object.domainValidationOptions[0].resourceRecordValue,
     }
 }
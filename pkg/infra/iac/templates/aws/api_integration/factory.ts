@@ -50,6 +50,6 @@ function properties(object: ReturnType<typeof create>, args: Args) {
     return {
         LbUri: pulumi.interpolate`http://${
             (args.Target as aws.lb.LoadBalancer).dnsName
-        }${args.Route.replace('+', '')}`,
+        }${args.Route.replace(/\+}/g, '}')}`,
     }
 }
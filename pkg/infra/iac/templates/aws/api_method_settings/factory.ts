@@ -0,0 +1,26 @@
+import * as aws from '@pulumi/aws'
+
+interface Args {
+    Name: string
+    Stage: aws.apigateway.Stage
+    MethodPath: string
+    ThrottlingRateLimit: number
+    ThrottlingBurstLimit: number
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.apigateway.MethodSettings {
+    return new aws.apigateway.MethodSettings(args.Name, {
+        restApi: args.Stage.restApi,
+        stageName: args.Stage.stageName,
+        methodPath: args.MethodPath,
+        settings: {
+            //TMPL {{- if .ThrottlingRateLimit }}
+            throttlingRateLimit: args.ThrottlingRateLimit,
+            //TMPL {{- end }}
+            //TMPL {{- if .ThrottlingBurstLimit }}
+            throttlingBurstLimit: args.ThrottlingBurstLimit,
+            //TMPL {{- end }}
+        },
+    })
+}
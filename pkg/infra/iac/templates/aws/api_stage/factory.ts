@@ -1,11 +1,15 @@
 import * as aws from '@pulumi/aws'
-import { ModelCaseWrapper } from '../../wrappers'
+import * as awsInputs from '@pulumi/aws/types/input'
+import { ModelCaseWrapper, TemplateWrapper } from '../../wrappers'
 
 interface Args {
     Name: string
     RestApi: aws.apigateway.RestApi
     Deployment: aws.apigateway.Deployment
     StageName: string
+    CanarySettings?: TemplateWrapper<Omit<awsInputs.apigateway.StageCanarySettings, 'deploymentId'>>
+    AccessLogGroup?: aws.cloudwatch.LogGroup
+    AccessLogFormat?: string
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
@@ -15,6 +19,30 @@ function create(args: Args): aws.apigateway.Stage {
         deployment: args.Deployment.id,
         restApi: args.RestApi.id,
         stageName: args.StageName,
+        //TMPL {{- if .CanarySettings }}
+        canarySettings: {
+            deploymentId: args.Deployment.id,
+            ...args.CanarySettings,
+        },
+        //TMPL {{- end }}
+        //TMPL {{- if .AccessLogGroup }}
+        accessLogSettings: {
+            destinationArn: args.AccessLogGroup.arn,
+            //TMPL {{- if .AccessLogFormat }}
+            format: args.AccessLogFormat,
+            //TMPL {{- else }}
+            format: JSON.stringify({
+                requestId: '$context.requestId',
+                sourceIp: '$context.identity.sourceIp',
+                requestTime: '$context.requestTime',
+                httpMethod: '$context.httpMethod',
+                resourcePath: '$context.resourcePath',
+                status: '$context.status',
+                responseLength: '$context.responseLength',
+            }),
+            //TMPL {{- end }}
+        },
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -0,0 +1,39 @@
+import * as aws from '@pulumi/aws'
+
+interface Args {
+    Name: string
+    Target: aws.appautoscaling.Target
+    PredefinedMetricType: string
+    TargetValue: number
+    ScaleInCooldown?: number
+    ScaleOutCooldown?: number
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.appautoscaling.Policy {
+    return new aws.appautoscaling.Policy(args.Name, {
+        policyType: 'TargetTrackingScaling',
+        serviceNamespace: args.Target.serviceNamespace,
+        resourceId: args.Target.resourceId,
+        scalableDimension: args.Target.scalableDimension,
+        targetTrackingScalingPolicyConfiguration: {
+            predefinedMetricSpecification: {
+                predefinedMetricType: args.PredefinedMetricType,
+            },
+            targetValue: args.TargetValue,
+            //TMPL {{- if .ScaleInCooldown }}
+            scaleInCooldown: args.ScaleInCooldown,
+            //TMPL {{- end }}
+            //TMPL {{- if .ScaleOutCooldown }}
+            scaleOutCooldown: args.ScaleOutCooldown,
+            //TMPL {{- end }}
+        },
+    })
+}
+
+function properties(object: aws.appautoscaling.Policy, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
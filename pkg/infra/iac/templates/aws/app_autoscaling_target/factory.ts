@@ -0,0 +1,33 @@
+import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Table: aws.dynamodb.Table
+    ScalableDimension: string
+    MinCapacity: number
+    MaxCapacity: number
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.appautoscaling.Target {
+    return new aws.appautoscaling.Target(args.Name, {
+        serviceNamespace: 'dynamodb',
+        resourceId: pulumi.interpolate`table/${args.Table.name}`,
+        scalableDimension: args.ScalableDimension,
+        minCapacity: args.MinCapacity,
+        maxCapacity: args.MaxCapacity,
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.appautoscaling.Target, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
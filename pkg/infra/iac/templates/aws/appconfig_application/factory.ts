@@ -0,0 +1,37 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Id?: string
+    Description?: string
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.appconfig.Application {
+    return new aws.appconfig.Application(
+        args.Name,
+        {
+            //TMPL {{- if .Description }}
+            description: args.Description,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { protect: args.protect }
+    )
+}
+
+function properties(object: aws.appconfig.Application, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
+
+function importResource(args: Args): aws.appconfig.Application {
+    return aws.appconfig.Application.get(args.Name, args.Id)
+}
@@ -0,0 +1,43 @@
+import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Application: aws.appconfig.Application
+    Environment: aws.appconfig.Environment
+    LocationUri: string
+    Type: string
+    Description?: string
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.appconfig.ConfigurationProfile {
+    return new aws.appconfig.ConfigurationProfile(
+        args.Name,
+        {
+            applicationId: args.Application.id,
+            locationUri: args.LocationUri,
+            type: args.Type,
+            //TMPL {{- if .Description }}
+            description: args.Description,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { protect: args.protect }
+    )
+}
+
+function properties(object: aws.appconfig.ConfigurationProfile, args: Args) {
+    return {
+        Arn: object.arn,
+        ConfigurationProfileId: object.configurationProfileId,
+        ConfigurationArn: pulumi.interpolate`${args.Application.arn}/environment/${
+            args.Environment.environmentId
+        }/configuration/${object.configurationProfileId}`,
+    }
+}
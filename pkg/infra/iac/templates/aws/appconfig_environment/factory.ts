@@ -0,0 +1,34 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Application: aws.appconfig.Application
+    Description?: string
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.appconfig.Environment {
+    return new aws.appconfig.Environment(
+        args.Name,
+        {
+            applicationId: args.Application.id,
+            //TMPL {{- if .Description }}
+            description: args.Description,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { protect: args.protect }
+    )
+}
+
+function properties(object: aws.appconfig.Environment, args: Args) {
+    return {
+        Arn: object.arn,
+        EnvironmentId: object.environmentId,
+    }
+}
@@ -14,43 +14,49 @@ interface Args {
     Aliases: string[]
     CustomErrorResponses: aws.types.input.cloudfront.DistributionCustomErrorResponse[]
     Tags: ModelCaseWrapper<Record<string, string>>
+    dependsOn?: pulumi.Input<pulumi.Input<pulumi.Resource>[]> | pulumi.Input<pulumi.Resource>
 }
 
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.cloudfront.Distribution {
-    return new aws.cloudfront.Distribution(args.Name, {
-        origins: args.Origins,
-        enabled: args.Enabled,
-        viewerCertificate: args.ViewerCertificate,
-        orderedCacheBehaviors: args.CacheBehaviors,
-        //TMPL {{- if .Aliases }}
-        aliases: args.Aliases,
-        //TMPL {{- end }}
-        //TMPL {{- if .CustomErrorResponses }}
-        customErrorResponses: args.CustomErrorResponses,
-        //TMPL {{- end }}
-        //TMPL {{- if (index .DefaultCacheBehavior "targetOriginId") }}
-        defaultCacheBehavior: args.DefaultCacheBehavior,
-        //TMPL {{- else }}
-        //TMPL defaultCacheBehavior: {
-        //TMPL     ...args.DefaultCacheBehavior,
-        //TMPL     targetOriginId: {{(index .Origins 0).originId}},
-        //TMPL },
-        //TMPL {{- end }}
-        restrictions: args.Restrictions,
-        //TMPL {{- if .DefaultRootObject }}
-        defaultRootObject: args.DefaultRootObject,
-        //TMPL {{- end }}
-        //TMPL {{- if .Tags }}
-        tags: args.Tags,
-        //TMPL {{- end }}
-    })
+    return new aws.cloudfront.Distribution(
+        args.Name,
+        {
+            origins: args.Origins,
+            enabled: args.Enabled,
+            viewerCertificate: args.ViewerCertificate,
+            orderedCacheBehaviors: args.CacheBehaviors,
+            //TMPL {{- if .Aliases }}
+            aliases: args.Aliases,
+            //TMPL {{- end }}
+            //TMPL {{- if .CustomErrorResponses }}
+            customErrorResponses: args.CustomErrorResponses,
+            //TMPL {{- end }}
+            //TMPL {{- if (index .DefaultCacheBehavior "targetOriginId") }}
+            defaultCacheBehavior: args.DefaultCacheBehavior,
+            //TMPL {{- else }}
+            //TMPL defaultCacheBehavior: {
+            //TMPL     ...args.DefaultCacheBehavior,
+            //TMPL     targetOriginId: {{(index .Origins 0).originId}},
+            //TMPL },
+            //TMPL {{- end }}
+            restrictions: args.Restrictions,
+            //TMPL {{- if .DefaultRootObject }}
+            defaultRootObject: args.DefaultRootObject,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { dependsOn: args.dependsOn }
+    )
 }
 
 function properties(object: ReturnType<typeof create>, args: Args) {
     return {
         DomainName: object.domainName,
         URLBase: pulumi.interpolate`https://${object.domainName}`,
+        HostedZoneId: object.hostedZoneId,
     }
 }
 
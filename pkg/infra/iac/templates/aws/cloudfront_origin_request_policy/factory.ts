@@ -0,0 +1,45 @@
+import * as aws from '@pulumi/aws'
+
+interface Args {
+    Name: string
+    Comment?: string
+    HeaderBehavior: string
+    Headers?: string[]
+    CookieBehavior: string
+    Cookies?: string[]
+    QueryStringBehavior: string
+    QueryStrings?: string[]
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.cloudfront.OriginRequestPolicy {
+    return new aws.cloudfront.OriginRequestPolicy(args.Name, {
+        //TMPL {{- if .Comment }}
+        comment: args.Comment,
+        //TMPL {{- end }}
+        headersConfig: {
+            headerBehavior: args.HeaderBehavior,
+            //TMPL {{- if .Headers }}
+            headers: { items: args.Headers },
+            //TMPL {{- end }}
+        },
+        cookiesConfig: {
+            cookieBehavior: args.CookieBehavior,
+            //TMPL {{- if .Cookies }}
+            cookies: { items: args.Cookies },
+            //TMPL {{- end }}
+        },
+        queryStringsConfig: {
+            queryStringBehavior: args.QueryStringBehavior,
+            //TMPL {{- if .QueryStrings }}
+            queryStrings: { items: args.QueryStrings },
+            //TMPL {{- end }}
+        },
+    })
+}
+
+function properties(object: aws.cloudfront.OriginRequestPolicy, args: Args) {
+    return {
+        Id: object.id,
+    }
+}
@@ -0,0 +1,37 @@
+import * as aws from '@pulumi/aws'
+
+interface Args {
+    Name: string
+    Id?: string
+    SigningProfileVersionArns: string[]
+    UntrustedArtifactOnDeployment: string
+    Description: string
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.lambda.CodeSigningConfig {
+    return new aws.lambda.CodeSigningConfig(args.Name, {
+        allowedPublishers: {
+            signingProfileVersionArns: args.SigningProfileVersionArns,
+        },
+        //TMPL {{- if .UntrustedArtifactOnDeployment }}
+        policies: {
+            untrustedArtifactOnDeployment: args.UntrustedArtifactOnDeployment,
+        },
+        //TMPL {{- end }}
+        //TMPL {{- if .Description }}
+        description: args.Description,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.lambda.CodeSigningConfig, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
+
+function importResource(args: Args): aws.lambda.CodeSigningConfig {
+    return aws.lambda.CodeSigningConfig.get(args.Name, args.Id)
+}
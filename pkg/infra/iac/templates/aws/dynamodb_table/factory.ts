@@ -9,10 +9,15 @@ interface Args {
     HashKey: string
     RangeKey: string
     BillingMode: string
+    ReadCapacity?: number
+    WriteCapacity?: number
+    TableClass: string
+    KmsKey?: aws.kms.Key
     GlobalSecondaryIndexes: pulumi.Input<
         pulumi.Input<awsInputs.dynamodb.TableGlobalSecondaryIndex>[]
     >
     LocalSecondaryIndexes: pulumi.Input<pulumi.Input<awsInputs.dynamodb.TableLocalSecondaryIndex>[]>
+    StreamViewType?: string
     protect: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
 }
@@ -27,6 +32,21 @@ function create(args: Args): aws.dynamodb.Table {
             rangeKey: args.RangeKey,
             //TMPL {{- end }}
             billingMode: args.BillingMode,
+            //TMPL {{- if .ReadCapacity }}
+            readCapacity: args.ReadCapacity,
+            //TMPL {{- end }}
+            //TMPL {{- if .WriteCapacity }}
+            writeCapacity: args.WriteCapacity,
+            //TMPL {{- end }}
+            //TMPL {{- if .TableClass }}
+            tableClass: args.TableClass,
+            //TMPL {{- end }}
+            //TMPL {{- if .KmsKey }}
+            serverSideEncryption: {
+                enabled: true,
+                kmsKeyArn: args.KmsKey.arn,
+            },
+            //TMPL {{- end }}
             //TMPL {{- if .Tags }}
             tags: args.Tags,
             //TMPL {{- end }}
@@ -36,6 +56,10 @@ function create(args: Args): aws.dynamodb.Table {
             //TMPL {{- if .LocalSecondaryIndexes}}
             localSecondaryIndexes: args.LocalSecondaryIndexes,
             //TMPL {{- end }}
+            //TMPL {{- if .StreamViewType }}
+            streamEnabled: true,
+            streamViewType: args.StreamViewType,
+            //TMPL {{- end }}
         },
         { protect: args.protect }
     )
@@ -44,6 +68,7 @@ function create(args: Args): aws.dynamodb.Table {
 function properties(object: aws.dynamodb.Table, args: Args) {
     return {
         Arn: object.arn,
+        StreamArn: object.streamArn,
         DynamoTableStreamArn: pulumi.interpolate`${object.arn}/stream/*`,
         DynamoTableBackupArn: pulumi.interpolate`${object.arn}/backup/*`,
         DynamoTableExportArn: pulumi.interpolate`${object.arn}/export/*`,
@@ -7,17 +7,37 @@ interface Args {
     SecurityGroups: aws.ec2.SecurityGroup[]
     Subnet: aws.ec2.Subnet
     AMI: aws.ec2.Ami
+    AmiSsmParameter: string
     InstanceType: string
+    NatInstance: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
 function create(args: Args): aws.ec2.Instance {
     return new aws.ec2.Instance(args.Name, {
+        //TMPL {{- if .AmiSsmParameter }}
+        ami: aws.ssm.getParameterOutput({ name: args.AmiSsmParameter }).value,
+        //TMPL {{- else }}
         ami: args.AMI.id,
+        //TMPL {{- end }}
         iamInstanceProfile: args.InstanceProfile,
         vpcSecurityGroupIds: args.SecurityGroups.map((sg) => sg.id),
         subnetId: args.Subnet.id,
         instanceType: args.InstanceType,
+        //TMPL {{- if .NatInstance }}
+        associatePublicIpAddress: true,
+        sourceDestCheck: false,
+        userData: `#!/bin/bash
+dnf install -y iptables-services
+systemctl enable --now iptables
+echo 'net.ipv4.ip_forward = 1' > /etc/sysctl.d/90-nat.conf
+sysctl -p /etc/sysctl.d/90-nat.conf
+iface=$(ip route show default | awk '{print $5; exit}')
+iptables -t nat -A POSTROUTING -o "$iface" -j MASQUERADE
+iptables -F FORWARD
+service iptables save
+`,
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -3,6 +3,7 @@ import { ModelCaseWrapper } from '../../wrappers'
 
 interface Args {
     Name: string
+    ScanOnPush: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
@@ -10,7 +11,7 @@ interface Args {
 function create(args: Args): aws.ecr.Repository {
     return new aws.ecr.Repository(args.Name, {
         imageScanningConfiguration: {
-            scanOnPush: true,
+            scanOnPush: args.ScanOnPush,
         },
         imageTagMutability: 'MUTABLE',
         forceDelete: true,
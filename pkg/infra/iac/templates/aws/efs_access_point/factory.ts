@@ -13,9 +13,7 @@ interface Args {
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.efs.AccessPoint {
     return new aws.efs.AccessPoint(args.Name, {
-        //TMPL {{- if .RootDirectory }}
         fileSystemId: args.FileSystem.id,
-        //TMPL {{- end }}
         //TMPL {{- if .PosixUser }}
         posixUser: args.PosixUser,
         //TMPL {{- end }}
@@ -0,0 +1,29 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Id?: string
+    Vpc: aws.ec2.Vpc
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.ec2.EgressOnlyInternetGateway {
+    return new aws.ec2.EgressOnlyInternetGateway(args.Name, {
+        vpcId: args.Vpc.id,
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.ec2.EgressOnlyInternetGateway, args: Args) {
+    return {
+        Id: object.id,
+    }
+}
+
+function importResource(args: Args): aws.ec2.EgressOnlyInternetGateway {
+    return aws.ec2.EgressOnlyInternetGateway.get(args.Name, args.Id)
+}
@@ -7,6 +7,7 @@ interface Args {
     SecurityGroups: aws.ec2.SecurityGroup[]
     ClusterRole: aws.iam.Role
     Version: string
+    SecretsEncryptionKey?: aws.kms.Key
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
@@ -21,6 +22,14 @@ function create(args: Args): aws.eks.Cluster {
             //TMPL {{- end }}
         },
         roleArn: args.ClusterRole.arn,
+        //TMPL {{- if .SecretsEncryptionKey }}
+        encryptionConfig: {
+            provider: {
+                keyArn: args.SecretsEncryptionKey.arn,
+            },
+            resources: ['secrets'],
+        },
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -12,36 +12,48 @@ interface Args {
     MinSize: number
     MaxSize: number
     MaxUnavailable: number
+    MaxUnavailablePercentage?: number
     DiskSize: number
     InstanceTypes: string[]
     Labels: Record<string, string>
     Tags: ModelCaseWrapper<Record<string, string>>
+    ignoreChanges?: string[]
 }
 
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.eks.NodeGroup {
-    return new aws.eks.NodeGroup(args.Name, {
-        clusterName: args.Cluster.name,
-        nodeRoleArn: args.NodeRole.arn,
-        //TMPL {{- if .AmiType }}
-        amiType: args.AmiType,
-        //TMPL {{- end }}
-        subnetIds: args.Subnets.map((subnet) => subnet.id),
-        scalingConfig: {
-            desiredSize: args.DesiredSize,
-            maxSize: args.MaxSize,
-            minSize: args.MinSize,
-        },
-        updateConfig: {
-            maxUnavailable: args.MaxUnavailable,
+    return new aws.eks.NodeGroup(
+        args.Name,
+        {
+            clusterName: args.Cluster.name,
+            nodeRoleArn: args.NodeRole.arn,
+            //TMPL {{- if .AmiType }}
+            amiType: args.AmiType,
+            //TMPL {{- end }}
+            subnetIds: args.Subnets.map((subnet) => subnet.id),
+            scalingConfig: {
+                desiredSize: args.DesiredSize,
+                maxSize: args.MaxSize,
+                minSize: args.MinSize,
+            },
+            updateConfig: {
+                //TMPL {{- if .MaxUnavailablePercentage }}
+                maxUnavailablePercentage: args.MaxUnavailablePercentage,
+                //TMPL {{- else }}
+                maxUnavailable: args.MaxUnavailable,
+                //TMPL {{- end }}
+            },
+            diskSize: args.DiskSize,
+            instanceTypes: args.InstanceTypes,
+            //TMPL {{- if .Labels }}
+            labels: args.Labels,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
         },
-        diskSize: args.DiskSize,
-        instanceTypes: args.InstanceTypes,
-        //TMPL {{- if .Labels }}
-        labels: args.Labels,
-        //TMPL {{- end }}
-        //TMPL {{- if .Tags }}
-        tags: args.Tags,
+        //TMPL {{- if .ignoreChanges }}
+        { ignoreChanges: args.ignoreChanges }
         //TMPL {{- end }}
-    })
+    )
 }
@@ -0,0 +1,52 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    EventBus?: aws.cloudwatch.EventBus
+    EventPattern?: string
+    ScheduleExpression?: string
+    Description?: string
+    State: string
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.cloudwatch.EventRule {
+    return new aws.cloudwatch.EventRule(
+        args.Name,
+        {
+            //TMPL {{- if .EventBus }}
+            eventBusName: args.EventBus.name,
+            //TMPL {{- end }}
+            //TMPL {{- if .EventPattern }}
+            eventPattern: args.EventPattern,
+            //TMPL {{- end }}
+            //TMPL {{- if .ScheduleExpression }}
+            scheduleExpression: args.ScheduleExpression,
+            //TMPL {{- end }}
+            //TMPL {{- if .Description }}
+            description: args.Description,
+            //TMPL {{- end }}
+            state: args.State,
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        //TMPL {{- if .protect }}
+        { protect: args.protect }
+        //TMPL {{- end }}
+    )
+}
+
+function properties(object: aws.cloudwatch.EventRule, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
+
+function importResource(args: Args): aws.cloudwatch.EventRule {
+    return aws.cloudwatch.EventRule.get(args.Name, args.Id)
+}
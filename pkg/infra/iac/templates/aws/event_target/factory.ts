@@ -0,0 +1,23 @@
+import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
+
+interface Args {
+    Name: string
+    Rule: aws.cloudwatch.EventRule
+    Target: pulumi.Output<string>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.cloudwatch.EventTarget {
+    return new aws.cloudwatch.EventTarget(args.Name, {
+        rule: args.Rule.name,
+        eventBusName: args.Rule.eventBusName,
+        arn: args.Target,
+    })
+}
+
+function properties(object: aws.cloudwatch.EventTarget, args: Args) {
+    return {
+        Id: object.id,
+    }
+}
@@ -0,0 +1,34 @@
+import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    ProtocolType: string
+    RouteSelectionExpression?: string
+    DisableExecuteApiEndpoint?: boolean
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.apigatewayv2.Api {
+    return new aws.apigatewayv2.Api(args.Name, {
+        protocolType: args.ProtocolType,
+        //TMPL {{- if .RouteSelectionExpression }}
+        routeSelectionExpression: args.RouteSelectionExpression,
+        //TMPL {{- end }}
+        //TMPL {{- if .DisableExecuteApiEndpoint }}
+        disableExecuteApiEndpoint: args.DisableExecuteApiEndpoint,
+        //TMPL {{- end }}
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.apigatewayv2.Api, args: Args) {
+    return {
+        ApiEndpoint: object.apiEndpoint,
+        ChildResources: pulumi.interpolate`${object.executionArn}/*/*`,
+    }
+}
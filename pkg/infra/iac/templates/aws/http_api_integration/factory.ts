@@ -0,0 +1,41 @@
+import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
+
+interface Args {
+    Name: string
+    Api: aws.apigatewayv2.Api
+    RouteKey: string
+    IntegrationType: string
+    IntegrationMethod?: string
+    IntegrationUri: pulumi.Output<string>
+    PayloadFormatVersion: string
+    TimeoutMilliseconds?: number
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.apigatewayv2.Integration {
+    return (() => {
+        const integration = new aws.apigatewayv2.Integration(args.Name, {
+            apiId: args.Api.id,
+            integrationType: args.IntegrationType,
+            integrationUri: args.IntegrationUri,
+            //TMPL {{- if .IntegrationMethod }}
+            integrationMethod: args.IntegrationMethod,
+            //TMPL {{- end }}
+            payloadFormatVersion: args.PayloadFormatVersion,
+            //TMPL {{- if .TimeoutMilliseconds }}
+            timeoutMilliseconds: args.TimeoutMilliseconds,
+            //TMPL {{- end }}
+        })
+        new aws.apigatewayv2.Route(
+            `${args.Name}-route`,
+            {
+                apiId: args.Api.id,
+                routeKey: args.RouteKey,
+                target: pulumi.interpolate`integrations/${integration.id}`,
+            },
+            { parent: integration }
+        )
+        return integration
+    })()
+}
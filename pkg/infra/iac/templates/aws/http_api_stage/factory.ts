@@ -0,0 +1,38 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Api: aws.apigatewayv2.Api
+    StageName: string
+    AutoDeploy: boolean
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.apigatewayv2.Stage {
+    return new aws.apigatewayv2.Stage(args.Name, {
+        apiId: args.Api.id,
+        name: args.StageName,
+        autoDeploy: args.AutoDeploy,
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: ReturnType<typeof create>, args: Args) {
+    return {
+        InvokeUrl: object.invokeUrl,
+    }
+}
+
+function infraExports(
+    object: ReturnType<typeof create>,
+    args: Args,
+    props: ReturnType<typeof properties>
+) {
+    return {
+        Url: object.invokeUrl,
+    }
+}
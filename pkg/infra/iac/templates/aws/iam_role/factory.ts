@@ -9,6 +9,7 @@ interface Args {
     InlinePolicies: TemplateWrapper<pulumi.Input<pulumi.Input<awsInputs.iam.RoleInlinePolicy>[]>>
     ManagedPolicies: pulumi.Output<string>[]
     AwsManagedPolicies: string[]
+    ExternalRoleArns: string[]
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
@@ -16,7 +17,26 @@ interface Args {
 function create(args: Args): aws.iam.Role {
     return new aws.iam.Role(args.Name, {
         assumeRolePolicy: pulumi.jsonStringify(args.AssumeRolePolicyDoc),
-        //TMPL {{- if .InlinePolicies }}
+        //TMPL {{- if .ExternalRoleArns }}
+        inlinePolicies: [
+            //TMPL {{- if .InlinePolicies }}
+            ...args.InlinePolicies,
+            //TMPL {{- end }}
+            {
+                name: `${args.Name}-assume-external-roles`,
+                policy: pulumi.jsonStringify({
+                    Version: '2012-10-17',
+                    Statement: [
+                        {
+                            Effect: 'Allow',
+                            Action: ['sts:AssumeRole'],
+                            Resource: args.ExternalRoleArns,
+                        },
+                    ],
+                }),
+            },
+        ],
+        //TMPL {{- else if .InlinePolicies }}
         inlinePolicies: args.InlinePolicies,
         //TMPL {{- end }}
         //TMPL {{- if or .ManagedPolicies .AwsManagedPolicies }}
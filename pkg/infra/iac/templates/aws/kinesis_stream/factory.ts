@@ -0,0 +1,46 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Id?: string
+    StreamMode: string
+    ShardCount?: number
+    RetentionPeriod?: number
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.kinesis.Stream {
+    return new aws.kinesis.Stream(
+        args.Name,
+        {
+            streamModeDetails: {
+                streamMode: args.StreamMode,
+            },
+            //TMPL {{- if ne .StreamMode "ON_DEMAND" }}
+            shardCount: args.ShardCount,
+            //TMPL {{- end }}
+            //TMPL {{- if .RetentionPeriod }}
+            retentionPeriod: args.RetentionPeriod,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        //TMPL {{- if .protect }}
+        { protect: args.protect }
+        //TMPL {{- end }}
+    )
+}
+
+function properties(object: aws.kinesis.Stream, args: Args) {
+    return {
+        Arn: object.arn,
+    }
+}
+
+function importResource(args: Args): aws.kinesis.Stream {
+    return aws.kinesis.Stream.get(args.Name, args.Id)
+}
@@ -0,0 +1,41 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Id?: string
+    Description?: string
+    EnableKeyRotation: boolean
+    DeletionWindowInDays: number
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.kms.Key {
+    return new aws.kms.Key(
+        args.Name,
+        {
+            //TMPL {{- if .Description }}
+            description: args.Description,
+            //TMPL {{- end }}
+            enableKeyRotation: args.EnableKeyRotation,
+            deletionWindowInDays: args.DeletionWindowInDays,
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { protect: args.protect }
+    )
+}
+
+function properties(object: aws.kms.Key, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
+
+function importResource(args: Args): aws.kms.Key {
+    return aws.kms.Key.get(args.Name, args.Id)
+}
@@ -4,10 +4,11 @@ import { ModelCaseWrapper } from '../../wrappers'
 
 interface Args {
     Name: string
-    EventSource: aws.sqs.Queue
+    EventSource: aws.sqs.Queue | aws.kinesis.Stream | aws.dynamodb.Table
     Function: aws.lambda.Function
     FilterCriteria?: ModelCaseWrapper<Record<string, string>[]>
     BatchSize?: number
+    StartingPosition?: string
     Enabled?: boolean
     FunctionResponseTypes?: string[]
     MaximumBatchingWindowInSeconds?: number
@@ -20,7 +21,10 @@ function create(args: Args): aws.lambda.EventSourceMapping {
     return new aws.lambda.EventSourceMapping(
         args.Name,
         {
-            eventSourceArn: args.EventSource.arn,
+            eventSourceArn:
+                args.EventSource instanceof aws.dynamodb.Table
+                    ? args.EventSource.streamArn
+                    : args.EventSource.arn,
             functionName: args.Function.name,
             //TMPL {{- if .FilterCriteria }}
             filterCriteria: {
@@ -30,6 +34,9 @@ function create(args: Args): aws.lambda.EventSourceMapping {
             //TMPL {{- if .BatchSize }}
             batchSize: args.BatchSize,
             //TMPL {{- end }}
+            //TMPL {{- if .StartingPosition }}
+            startingPosition: args.StartingPosition,
+            //TMPL {{- end }}
             //TMPL {{- if .Enabled }}
             enabled: args.Enabled,
             //TMPL {{- end }}
@@ -6,17 +6,21 @@ import * as path from 'path'
 interface Args {
     Name: string
     Image: string
+    ImageConfig: TemplateWrapper<aws.types.input.lambda.FunctionImageConfig>
     ExecutionRole: aws.iam.Role
     EnvironmentVariables: ModelCaseWrapper<Record<string, pulumi.Output<string>>>
     Subnets: aws.ec2.Subnet[]
     SecurityGroups: aws.ec2.SecurityGroup[]
     MemorySize: pulumi.Input<number>
     Timeout: pulumi.Input<number>
+    Layers: string[]
     EfsAccessPoint: aws.efs.AccessPoint
+    CodeSigningConfig: aws.lambda.CodeSigningConfig
     Tags: ModelCaseWrapper<Record<string, string>>
     Code: string
     Handler: string
     Runtime: string
+    Architecture: string
     S3Bucket: string
     S3Key: string
     S3ObjectVersion: string
@@ -48,6 +52,12 @@ function create(args: Args): aws.lambda.Function {
             //TMPL {{- else if .Image }}
             packageType: 'Image',
             imageUri: args.Image,
+            //TMPL {{- if .ImageConfig }}
+            imageConfig: args.ImageConfig,
+            //TMPL {{- end }}
+            //TMPL {{- end }}
+            //TMPL {{- if .Architecture }}
+            architectures: [args.Architecture],
             //TMPL {{- end }}
             //TMPL {{- if .MemorySize }}
             memorySize: args.MemorySize,
@@ -56,12 +66,18 @@ function create(args: Args): aws.lambda.Function {
             timeout: args.Timeout,
             //TMPL {{- end }}
             role: args.ExecutionRole.arn,
+            //TMPL {{- if .Layers }}
+            layers: args.Layers,
+            //TMPL {{- end }}
             //TMPL {{- if .EfsAccessPoint }}
             fileSystemConfig: {
                 arn: args.EfsAccessPoint.arn,
                 localMountPath: args.EfsAccessPoint.rootDirectory.path,
             },
             //TMPL {{- end }}
+            //TMPL {{- if .CodeSigningConfig }}
+            codeSigningConfigArn: args.CodeSigningConfig.arn,
+            //TMPL {{- end }}
             //TMPL {{- if and .SecurityGroups .Subnets }}
             vpcConfig: {
                 securityGroupIds: args.SecurityGroups.map((sg) => sg.id),
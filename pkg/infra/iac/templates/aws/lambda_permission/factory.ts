@@ -4,7 +4,7 @@ interface Args {
     Name: string
     Function: aws.lambda.Function
     Principal: string
-    Source: pulumi.Output<string>
+    Source?: pulumi.Output<string>
     Action: string
 }
 
@@ -14,6 +14,8 @@ function create(args: Args): aws.lambda.Permission {
         action: args.Action,
         function: args.Function.name,
         principal: args.Principal,
+        //TMPL {{- if .Source }}
         sourceArn: args.Source,
+        //TMPL {{- end }}
     })
 }
@@ -11,6 +11,8 @@ interface Args {
     Tags: ModelCaseWrapper<Record<string, string>>
     Type: string
     Id: string
+    AccessLogsBucket?: aws.s3.Bucket
+    AccessLogsPrefix?: string
 }
 
 // noinspection JSUnusedLocalSymbols
@@ -26,6 +28,15 @@ function create(args: Args): aws.lb.LoadBalancer {
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
+        //TMPL {{- if .AccessLogsBucket }}
+        accessLogs: {
+            bucket: args.AccessLogsBucket.bucket,
+            //TMPL {{- if .AccessLogsPrefix }}
+            prefix: args.AccessLogsPrefix,
+            //TMPL {{- end }}
+            enabled: true,
+        },
+        //TMPL {{- end }}
         //TMPL {{- if .SecurityGroups }}
         securityGroups: args.SecurityGroups.map((sg) => sg.id),
         //TMPL {{- end }}
@@ -36,6 +47,7 @@ function properties(object: aws.lb.LoadBalancer, args: Args) {
     return {
         NlbUri: pulumi.interpolate`http://${object.dnsName}`,
         DnsName: object.dnsName,
+        ZoneId: object.zoneId,
     }
 }
 
@@ -1,4 +1,5 @@
 import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
 import { TemplateWrapper, ModelCaseWrapper } from '../../wrappers'
 
 interface Args {
@@ -6,19 +7,28 @@ interface Args {
     Port: number
     Protocol: string
     LoadBalancer: aws.lb.LoadBalancer
+    Certificate?: aws.acm.Certificate
     DefaultActions: TemplateWrapper<aws.types.input.lb.ListenerDefaultAction[]>
     Tags: ModelCaseWrapper<Record<string, string>>
+    dependsOn?: pulumi.Input<pulumi.Input<pulumi.Resource>[]> | pulumi.Input<pulumi.Resource>
 }
 
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.lb.Listener {
-    return new aws.lb.Listener(args.Name, {
-        loadBalancerArn: args.LoadBalancer.arn,
-        defaultActions: args.DefaultActions,
-        port: args.Port,
-        protocol: args.Protocol,
-        //TMPL {{- if .Tags }}
-        tags: args.Tags,
-        //TMPL {{- end }}
-    })
+    return new aws.lb.Listener(
+        args.Name,
+        {
+            loadBalancerArn: args.LoadBalancer.arn,
+            defaultActions: args.DefaultActions,
+            port: args.Port,
+            protocol: args.Protocol,
+            //TMPL {{- if .Certificate }}
+            certificateArn: args.Certificate.arn,
+            //TMPL {{- end }}
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        { dependsOn: args.dependsOn }
+    )
 }
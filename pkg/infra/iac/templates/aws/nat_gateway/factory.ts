@@ -4,7 +4,8 @@ import { ModelCaseWrapper } from '../../wrappers'
 interface Args {
     Name: string
     Id?: string
-    ElasticIp: aws.ec2.Eip
+    ConnectivityType: string
+    ElasticIp?: aws.ec2.Eip
     Subnet: aws.ec2.Subnet
     Tags: ModelCaseWrapper<Record<string, string>>
 }
@@ -12,7 +13,12 @@ interface Args {
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.ec2.NatGateway {
     return new aws.ec2.NatGateway(args.Name, {
+        //TMPL {{- if .ElasticIp }}
         allocationId: args.ElasticIp.id,
+        //TMPL {{- end }}
+        //TMPL {{- if .ConnectivityType }}
+        connectivityType: args.ConnectivityType,
+        //TMPL {{- end }}
         subnetId: args.Subnet.id,
         //TMPL {{- if .Tags }}
         tags: args.Tags,
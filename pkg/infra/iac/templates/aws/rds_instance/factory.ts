@@ -1,5 +1,6 @@
 import * as pulumi from '@pulumi/pulumi'
 import * as aws from '@pulumi/aws'
+import * as random from '@pulumi/random'
 import { accountId, region, kloConfig } from '../../globals'
 import { ModelCaseWrapper } from '../../wrappers'
 
@@ -7,6 +8,7 @@ interface Args {
     Name: string
     Arn: string
     SubnetGroup: aws.rds.SubnetGroup
+    ParameterGroup: aws.rds.ParameterGroup
     SecurityGroups: aws.ec2.SecurityGroup[]
     IamDatabaseAuthenticationEnabled: boolean
     DatabaseName: string
@@ -15,8 +17,10 @@ interface Args {
     InstanceClass: string
     SkipFinalSnapshot: boolean
     AllocatedStorage: number
+    MultiAz: boolean
     Username: string
     Password: string
+    PasswordLength: number
     protect: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
 }
@@ -38,13 +42,34 @@ function create(args: Args): aws.rds.Instance {
             //TMPL {{- if .Password }}
             //TMPL password: {{ .Password }},
             //TMPL {{- else }}
-            password: kloConfig.requireSecret(`${args.Name}-password`),
+            // The '<name>-password' config secret is used when it is set, otherwise a password is generated. Only
+            // symbols which every engine accepts and which don't need escaping in the connection string or the
+            // credentials JSON are used
+            password:
+                kloConfig.getSecret(`${args.Name}-password`) ??
+                new random.RandomPassword(`${args.Name}-password`, {
+                    length: Math.min(
+                        args.PasswordLength,
+                        /oracle/.test(args.Engine) ? 30 : /mysql|mariadb/.test(args.Engine) ? 41 : 128
+                    ),
+                    overrideSpecial: '!*()-_.~',
+                    minLower: 1,
+                    minUpper: 1,
+                    minNumeric: 1,
+                    minSpecial: 1,
+                }).result,
             //TMPL {{- end }}
             iamDatabaseAuthenticationEnabled: args.IamDatabaseAuthenticationEnabled,
             dbSubnetGroupName: args.SubnetGroup.name,
+            //TMPL {{- if .ParameterGroup }}
+            parameterGroupName: args.ParameterGroup.name,
+            //TMPL {{- end }}
             vpcSecurityGroupIds: args.SecurityGroups.map((sg) => sg.id),
             skipFinalSnapshot: args.SkipFinalSnapshot,
             allocatedStorage: args.AllocatedStorage,
+            //TMPL {{- if .MultiAz }}
+            multiAz: args.MultiAz,
+            //TMPL {{- end }}
             //TMPL {{- if .Tags }}
             tags: args.Tags,
             //TMPL {{- end }}
@@ -63,7 +88,8 @@ function properties(object: aws.rds.Instance, args: Args) {
         }),
         RdsConnectionArn: pulumi.interpolate`arn:aws:rds-db:${region.name}:${accountId.accountId}:dbuser:${object.resourceId}/${object.username}`,
         Endpoint: object.endpoint,
-        ConnectionString: pulumi.interpolate`${args.Engine}://${object.username}:${object.password}@${object.endpoint}/${args.DatabaseName}`,
+        Identifier: object.identifier,
+        ConnectionString: pulumi.interpolate`${object.engine.apply((engine) => (/mysql|mariadb/.test(engine) ? 'mysql' : engine))}://${object.username}:${object.password}@${object.endpoint}/${args.DatabaseName}`,
         Host: object.endpoint.apply((endpoint) => endpoint.split(':')[0]),
         Port: object.endpoint.apply((endpoint) => endpoint.split(':')[1]),
     }
@@ -0,0 +1,39 @@
+import * as aws from '@pulumi/aws'
+import * as awsInputs from '@pulumi/aws/types/input'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Family: string
+    Description: string
+    Parameters: awsInputs.rds.ParameterGroupParameter[]
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.rds.ParameterGroup {
+    return new aws.rds.ParameterGroup(args.Name, {
+        family: args.Family,
+        //TMPL {{- if .Description }}
+        description: args.Description,
+        //TMPL {{- end }}
+        //TMPL {{- if .Parameters }}
+        parameters: args.Parameters,
+        //TMPL {{- end }}
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.rds.ParameterGroup, args: Args) {
+    return {
+        DeployedName: object.name,
+    }
+}
+
+type AllProperties = Args & ReturnType<typeof properties>
+
+function importResource(args: AllProperties): aws.rds.ParameterGroup {
+    return aws.rds.ParameterGroup.get(args.Name, args.DeployedName)
+}
@@ -0,0 +1,31 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    SourceInstance: aws.rds.Instance
+    InstanceClass: string
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.rds.Instance {
+    return new aws.rds.Instance(args.Name, {
+        replicateSourceDb: args.SourceInstance.identifier,
+        instanceClass: args.InstanceClass,
+        // Replicas in the same region use the source's subnet group, but not its security groups
+        vpcSecurityGroupIds: args.SourceInstance.vpcSecurityGroupIds,
+        iamDatabaseAuthenticationEnabled: args.SourceInstance.iamDatabaseAuthenticationEnabled,
+        skipFinalSnapshot: true,
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.rds.Instance, args: Args) {
+    return {
+        Endpoint: object.endpoint,
+        Identifier: object.identifier,
+    }
+}
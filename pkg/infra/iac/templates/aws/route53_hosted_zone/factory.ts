@@ -0,0 +1,39 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    DomainName: string
+    Comment?: string
+    ForceDestroy?: boolean
+    Tags: ModelCaseWrapper<Record<string, string>>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.route53.Zone {
+    return new aws.route53.Zone(args.Name, {
+        name: args.DomainName,
+        //TMPL {{- if .Comment }}
+        comment: args.Comment,
+        //TMPL {{- end }}
+        //TMPL {{- if .ForceDestroy }}
+        forceDestroy: args.ForceDestroy,
+        //TMPL {{- end }}
+        //TMPL {{- if .Tags }}
+        tags: args.Tags,
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.route53.Zone, args: Args) {
+    return {
+        ZoneId: object.zoneId,
+        NameServers: object.nameServers,
+    }
+}
+
+type AllProperties = Args & ReturnType<typeof properties>
+
+function importResource(args: AllProperties): aws.route53.Zone {
+    return aws.route53.Zone.get(args.Name, args.ZoneId)
+}
@@ -0,0 +1,39 @@
+import * as aws from '@pulumi/aws'
+import * as awsInputs from '@pulumi/aws/types/input'
+import { TemplateWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    HostedZone: aws.route53.Zone
+    RecordName: string
+    Type: string
+    Ttl?: number
+    Records?: string[]
+    Alias?: TemplateWrapper<awsInputs.route53.RecordAlias>
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.route53.Record {
+    return new aws.route53.Record(args.Name, {
+        zoneId: args.HostedZone.zoneId,
+        name: args.RecordName,
+        type: args.Type,
+        //TMPL {{- if .Alias }}
+        // Alias records can't have a TTL or values
+        aliases: [args.Alias],
+        //TMPL {{- else }}
+        //TMPL {{- if .Ttl }}
+        ttl: args.Ttl,
+        //TMPL {{- end }}
+        //TMPL {{- if .Records }}
+        records: args.Records,
+        //TMPL {{- end }}
+        //TMPL {{- end }}
+    })
+}
+
+function properties(object: aws.route53.Record, args: Args) {
+    return {
+        Fqdn: object.fqdn,
+    }
+}
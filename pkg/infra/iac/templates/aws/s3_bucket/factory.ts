@@ -7,6 +7,16 @@ interface Args {
     ForceDestroy: boolean
     IndexDocument: string
     SSEAlgorithm: string
+    ObjectOwnership: string
+    CorsRules: pulumi.Input<pulumi.Input<aws.types.input.s3.BucketCorsRule>[]>
+    TransferAcceleration: boolean
+    Versioning: boolean
+    LifecycleRules: {
+        prefix?: string
+        transitionDays?: number
+        storageClass?: string
+        expirationDays?: number
+    }[]
     protect: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
     Bucket: string
@@ -15,40 +25,84 @@ interface Args {
 
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.s3.Bucket {
-    return new aws.s3.Bucket(
-        args.Name,
-        {
-            //TMPL {{- if .Bucket }}
-            bucket: args.Bucket,
-            //TMPL {{- end }}
-            forceDestroy: args.ForceDestroy,
-            //TMPL {{- if .SSEAlgorithm }}
-            serverSideEncryptionConfiguration: {
-                rule: {
-                    applyServerSideEncryptionByDefault: {
-                        sseAlgorithm: args.SSEAlgorithm,
+    return (() => {
+        const bucket = new aws.s3.Bucket(
+            args.Name,
+            {
+                //TMPL {{- if .Bucket }}
+                bucket: args.Bucket,
+                //TMPL {{- end }}
+                forceDestroy: args.ForceDestroy,
+                //TMPL {{- if .SSEAlgorithm }}
+                serverSideEncryptionConfiguration: {
+                    rule: {
+                        applyServerSideEncryptionByDefault: {
+                            sseAlgorithm: args.SSEAlgorithm,
+                        },
+                        bucketKeyEnabled: true,
                     },
-                    bucketKeyEnabled: true,
                 },
+                //TMPL {{- end }}
+                //TMPL {{- if .CorsRules }}
+                corsRules: args.CorsRules,
+                //TMPL {{- end }}
+                //TMPL {{- if .TransferAcceleration }}
+                accelerationStatus: 'Enabled',
+                //TMPL {{- end }}
+                //TMPL {{- if .Versioning }}
+                versioning: {
+                    enabled: args.Versioning,
+                },
+                //TMPL {{- end }}
+                //TMPL {{- if .LifecycleRules }}
+                lifecycleRules: args.LifecycleRules.map((rule) => ({
+                    enabled: true,
+                    prefix: rule.prefix,
+                    // The transition is listed before the expiration, which must be later than it
+                    transitions:
+                        rule.transitionDays !== undefined
+                            ? [{ days: rule.transitionDays, storageClass: rule.storageClass! }]
+                            : undefined,
+                    expiration:
+                        rule.expirationDays !== undefined
+                            ? { days: rule.expirationDays }
+                            : undefined,
+                })),
+                //TMPL {{- end }}
+                //TMPL {{- if .IndexDocument }}
+                website: {
+                    indexDocument: args.IndexDocument,
+                },
+                //TMPL {{- end }}
+                //TMPL {{- if .Tags }}
+                tags: args.Tags,
+                //TMPL {{- end }}
             },
-            //TMPL {{- end }}
-            //TMPL {{- if .IndexDocument }}
-            website: {
-                indexDocument: args.IndexDocument,
+            { protect: args.protect }
+        )
+
+        //TMPL {{- if .ObjectOwnership }}
+        new aws.s3.BucketOwnershipControls(
+            `${args.Name}-ownership-controls`,
+            {
+                bucket: bucket.id,
+                rule: {
+                    objectOwnership: args.ObjectOwnership,
+                },
             },
-            //TMPL {{- end }}
-            //TMPL {{- if .Tags }}
-            tags: args.Tags,
-            //TMPL {{- end }}
-        },
-        { protect: args.protect }
-    )
+            { parent: bucket, protect: args.protect }
+        )
+        //TMPL {{- end }}
+        return bucket
+    })()
 }
+
 function properties(object: aws.s3.Bucket, args: Args) {
     return {
         AllBucketDirectory: pulumi.interpolate`${object.arn}/*`,
         Arn: object.arn,
         BucketRegionalDomainName: object.bucketRegionalDomainName,
+        AccelerateEndpoint: pulumi.interpolate`${object.bucket}.s3-accelerate.amazonaws.com`,
         Bucket: object.bucket,
         Id: object.id,
     }
@@ -8,6 +8,8 @@ interface Args {
     Bucket: aws.s3.Bucket
     Key: string
     FilePath: string
+    ContentType: string
+    CacheControl: string
     Tags: ModelCaseWrapper<Record<string, string>>
 }
 
@@ -17,7 +19,14 @@ function create(args: Args): aws.s3.BucketObject {
         bucket: args.Bucket,
         key: args.Key,
         source: new pulumi.asset.FileAsset(args.FilePath), // use FileAsset to point to a file
+        //TMPL {{- if .ContentType }}
+        contentType: args.ContentType,
+        //TMPL {{- else }}
         contentType: mime.getType(args.FilePath) || undefined, // set the MIME type of the file
+        //TMPL {{- end }}
+        //TMPL {{- if .CacheControl }}
+        cacheControl: args.CacheControl,
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -3,6 +3,7 @@ import { ModelCaseWrapper } from '../../wrappers'
 
 interface Args {
     Name: string
+    Arn: string
     protect: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
 }
@@ -28,3 +29,7 @@ function properties(object: aws.secretsmanager.Secret, args: Args) {
         Id: object.id,
     }
 }
+
+function importResource(args: Args): aws.secretsmanager.Secret {
+    return aws.secretsmanager.Secret.get(args.Name, args.Arn)
+}
@@ -9,6 +9,15 @@ interface Args {
     ArchivePolicy: string
     ContentBasedDeduplication: boolean
     DeliveryPolicy: string
+    HttpRetryPolicy?: {
+        minDelayTarget?: number
+        maxDelayTarget?: number
+        numRetries?: number
+        numNoDelayRetries?: number
+        numMinDelayRetries?: number
+        numMaxDelayRetries?: number
+        backoffFunction?: string
+    }
     FifoTopic: boolean
     FirehoseFailureFeedbackRoleArn: string
     FirehoseSuccessFeedbackRoleArn: string
@@ -17,6 +26,7 @@ interface Args {
     HttpSuccessFeedbackRoleArn: string
     HttpSuccessFeedbackSampleRate: number
     KmsMasterKeyId: string
+    KmsKey?: aws.kms.Key
     LambdaFailureFeedbackRoleArn: string
     LambdaSuccessFeedbackRoleArn: string
     LambdaSuccessFeedbackSampleRate: number
@@ -49,6 +59,10 @@ function create(args: Args): aws.sns.Topic {
         //TMPL {{- end }}
         //TMPL {{- if .DeliveryPolicy }}
         deliveryPolicy: args.DeliveryPolicy,
+        //TMPL {{- else if .HttpRetryPolicy }}
+        deliveryPolicy: JSON.stringify({
+            http: { defaultHealthyRetryPolicy: args.HttpRetryPolicy },
+        }),
         //TMPL {{- end }}
         //TMPL {{- if .FifoTopic }}
         fifoTopic: args.FifoTopic,
@@ -71,7 +85,9 @@ function create(args: Args): aws.sns.Topic {
         //TMPL {{- if .HttpSuccessFeedbackSampleRate }}
         httpSuccessFeedbackSampleRate: args.HttpSuccessFeedbackSampleRate,
         //TMPL {{- end }}
-        //TMPL {{- if .KmsMasterKeyId }}
+        //TMPL {{- if .KmsKey }}
+        kmsMasterKeyId: args.KmsKey.arn,
+        //TMPL {{- else if .KmsMasterKeyId }}
         kmsMasterKeyId: args.KmsMasterKeyId,
         //TMPL {{- end }}
         //TMPL {{- if .LambdaFailureFeedbackRoleArn }}
@@ -7,6 +7,7 @@ interface Args {
     DelaySeconds?: number
     MaxMessageSize?: number
     VisibilityTimeout?: number
+    MessageRetentionPeriod?: number
     Tags: ModelCaseWrapper<Record<string, string>>
     protect: boolean
 }
@@ -28,6 +29,9 @@ function create(args: Args): aws.sqs.Queue {
             //TMPL {{- if .VisibilityTimeout }}
             visibilityTimeoutSeconds: args.VisibilityTimeout,
             //TMPL {{- end }}
+            //TMPL {{- if .MessageRetentionPeriod }}
+            messageRetentionSeconds: args.MessageRetentionPeriod,
+            //TMPL {{- end }}
             //TMPL {{- if .Tags }}
             tags: args.Tags,
             //TMPL {{- end }}
@@ -41,6 +45,7 @@ function create(args: Args): aws.sqs.Queue {
 function properties(object: aws.sqs.Queue, args: Args) {
     return {
         Arn: object.arn,
+        Url: object.url,
     }
 }
 
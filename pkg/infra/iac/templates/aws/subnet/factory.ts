@@ -8,6 +8,8 @@ interface Args {
     Vpc: aws.ec2.Vpc
     AvailabilityZone: pulumi.Output<string>
     MapPublicIpOnLaunch: boolean
+    AssignIpv6AddressOnCreation: boolean
+    Ipv6CidrBlockIndex: number
     Id?: string
     Tags: ModelCaseWrapper<Record<string, string>>
 }
@@ -19,6 +21,18 @@ function create(args: Args): aws.ec2.Subnet {
         cidrBlock: args.CidrBlock,
         availabilityZone: args.AvailabilityZone,
         mapPublicIpOnLaunch: args.MapPublicIpOnLaunch,
+        //TMPL {{- if .AssignIpv6AddressOnCreation }}
+        // the VPC's block is a /56, so take its Ipv6CidrBlockIndex-th /64
+        ipv6CidrBlock: args.Vpc.ipv6CidrBlock.apply((cidr) => {
+            const hextets = cidr.split('::')[0].split(':')
+            while (hextets.length < 4) {
+                hextets.push('0')
+            }
+            hextets[3] = (parseInt(hextets[3], 16) + args.Ipv6CidrBlockIndex).toString(16)
+            return `${hextets.join(':')}::/64`
+        }),
+        assignIpv6AddressOnCreation: args.AssignIpv6AddressOnCreation,
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -28,6 +42,7 @@ function create(args: Args): aws.ec2.Subnet {
 function properties(object: aws.ec2.Subnet, args: Args) {
     return {
         Id: object.id,
+        Ipv6CidrBlock: object.ipv6CidrBlock,
     }
 }
 
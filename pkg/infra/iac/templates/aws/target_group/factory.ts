@@ -1,4 +1,5 @@
 import * as aws from '@pulumi/aws'
+import * as pulumi from '@pulumi/pulumi'
 import * as awsInputs from '@pulumi/aws/types/input'
 import { ModelCaseWrapper, TemplateWrapper } from '../../wrappers'
 
@@ -8,42 +9,62 @@ interface Args {
     Protocol: string
     Vpc: aws.ec2.Vpc
     TargetType: string
-    Targets: { Id: string; Port: number }[]
+    Targets: { id: pulumi.Input<string>; port: number }[]
     HealthCheck: TemplateWrapper<awsInputs.lb.TargetGroupHealthCheck>
     LambdaMultiValueHeadersEnabled?: boolean
+    DeregistrationDelay?: number
+    SlowStart?: number
+    ConnectionTermination?: boolean
     Tags: ModelCaseWrapper<Record<string, string>>
     Id: string
+    dependsOn?: pulumi.Input<pulumi.Input<pulumi.Resource>[]> | pulumi.Input<pulumi.Resource>
 }
 
 // noinspection JSUnusedLocalSymbols
 function create(args: Args): aws.lb.TargetGroup {
     return (() => {
-        const tg = new aws.lb.TargetGroup(args.Name, {
-            port: args.Port,
-            protocol: args.Protocol,
-            targetType: args.TargetType,
-            vpcId: args.Vpc.id,
-            healthCheck: args.HealthCheck,
-            //TMPL {{- if .LambdaMultiValueHeadersEnabled }}
-            lambdaMultiValueHeadersEnabled: args.LambdaMultiValueHeadersEnabled,
-            //TMPL {{- end }}
-            //TMPL {{- if .Tags }}
-            tags: args.Tags,
-            //TMPL {{- end }}
-        })
+        const tg = new aws.lb.TargetGroup(
+            args.Name,
+            {
+                //TMPL {{- if ne .TargetType "lambda" }}
+                port: args.Port,
+                protocol: args.Protocol,
+                vpcId: args.Vpc.id,
+                //TMPL {{- end }}
+                targetType: args.TargetType,
+                healthCheck: args.HealthCheck,
+                //TMPL {{- if .LambdaMultiValueHeadersEnabled }}
+                lambdaMultiValueHeadersEnabled: args.LambdaMultiValueHeadersEnabled,
+                //TMPL {{- end }}
+                //TMPL {{- if .DeregistrationDelay }}
+                deregistrationDelay: args.DeregistrationDelay,
+                //TMPL {{- end }}
+                //TMPL {{- if .SlowStart }}
+                slowStart: args.SlowStart,
+                //TMPL {{- end }}
+                //TMPL {{- if .ConnectionTermination }}
+                connectionTermination: args.ConnectionTermination,
+                //TMPL {{- end }}
+                //TMPL {{- if .Tags }}
+                tags: args.Tags,
+                //TMPL {{- end }}
+            },
+            // lambda targets depend on their invoke permission, which must exist before they are registered
+            { dependsOn: args.dependsOn }
+        )
 
         //TMPL {{- if .Targets }}
-        for (const target of args.Targets) {
+        for (const [i, target] of args.Targets.entries()) {
             //TMPL {{- if eq .TargetType "instance" }}
-            new aws.lb.TargetGroupAttachment(target.Id, {
-                port: target.Port,
+            new aws.lb.TargetGroupAttachment(`${args.Name}-${i}`, {
+                port: target.port,
                 targetGroupArn: tg.arn,
-                targetId: target.Id,
+                targetId: target.id,
             })
             //TMPL {{- else if eq .TargetType "lambda" }}
-            new aws.lb.TargetGroupAttachment(target.Id, {
+            new aws.lb.TargetGroupAttachment(`${args.Name}-${i}`, {
                 targetGroupArn: tg.arn,
-                targetId: target.Id,
+                targetId: target.id,
             })
             //TMPL {{- end }}
         }
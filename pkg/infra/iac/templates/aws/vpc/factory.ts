@@ -6,6 +6,7 @@ interface Args {
     CidrBlock: string
     EnableDnsHostnames: boolean
     EnableDnsSupport: boolean
+    AssignGeneratedIpv6CidrBlock: boolean
     Arn?: string
     Id?: string
     Tags: ModelCaseWrapper<Record<string, string>>
@@ -17,6 +18,9 @@ function create(args: Args): aws.ec2.Vpc {
         cidrBlock: args.CidrBlock,
         enableDnsHostnames: args.EnableDnsHostnames,
         enableDnsSupport: args.EnableDnsSupport,
+        //TMPL {{- if .AssignGeneratedIpv6CidrBlock }}
+        assignGeneratedIpv6CidrBlock: args.AssignGeneratedIpv6CidrBlock,
+        //TMPL {{- end }}
         //TMPL {{- if .Tags }}
         tags: args.Tags,
         //TMPL {{- end }}
@@ -27,6 +31,7 @@ function properties(object: aws.ec2.Vpc, args: Args) {
     return {
         Id: object.id,
         Arn: object.arn,
+        Ipv6CidrBlock: object.ipv6CidrBlock,
     }
 }
 
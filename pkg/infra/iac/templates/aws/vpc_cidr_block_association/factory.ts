@@ -0,0 +1,21 @@
+import * as aws from '@pulumi/aws'
+
+interface Args {
+    Name: string
+    Vpc: aws.ec2.Vpc
+    CidrBlock: string
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.ec2.VpcIpv4CidrBlockAssociation {
+    return new aws.ec2.VpcIpv4CidrBlockAssociation(args.Name, {
+        vpcId: args.Vpc.id,
+        cidrBlock: args.CidrBlock,
+    })
+}
+
+function properties(object: aws.ec2.VpcIpv4CidrBlockAssociation, args: Args) {
+    return {
+        Id: object.id,
+    }
+}
@@ -0,0 +1,80 @@
+import * as aws from '@pulumi/aws'
+import { ModelCaseWrapper } from '../../wrappers'
+
+interface Args {
+    Name: string
+    Id: string
+    Scope: string
+    DefaultAction: string
+    ManagedRuleGroups: Record<string, any>[]
+    RateBasedRules: Record<string, any>[]
+    Tags: ModelCaseWrapper<Record<string, string>>
+    protect: boolean
+}
+
+// noinspection JSUnusedLocalSymbols
+function create(args: Args): aws.wafv2.WebAcl {
+    return new aws.wafv2.WebAcl(
+        args.Name,
+        {
+            scope: args.Scope,
+            //TMPL defaultAction: { {{ .DefaultAction }}: {} },
+            rules: [
+                //TMPL {{- range .ManagedRuleGroups }}
+                //TMPL {
+                //TMPL     name: {{ .name }},
+                //TMPL     priority: {{ .priority }},
+                //TMPL     overrideAction: { none: {} },
+                //TMPL     statement: {
+                //TMPL         managedRuleGroupStatement: {
+                //TMPL             name: {{ .name }},
+                //TMPL             vendorName: {{ .vendorName }},
+                //TMPL         },
+                //TMPL     },
+                //TMPL     visibilityConfig: {
+                //TMPL         cloudwatchMetricsEnabled: true,
+                //TMPL         metricName: {{ .name }},
+                //TMPL         sampledRequestsEnabled: true,
+                //TMPL     },
+                //TMPL },
+                //TMPL {{- end }}
+                //TMPL {{- range .RateBasedRules }}
+                //TMPL {
+                //TMPL     name: {{ .name }},
+                //TMPL     priority: {{ .priority }},
+                //TMPL     action: { {{ .action }}: {} },
+                //TMPL     statement: {
+                //TMPL         rateBasedStatement: {
+                //TMPL             limit: {{ .limit }},
+                //TMPL             aggregateKeyType: 'IP',
+                //TMPL         },
+                //TMPL     },
+                //TMPL     visibilityConfig: {
+                //TMPL         cloudwatchMetricsEnabled: true,
+                //TMPL         metricName: {{ .name }},
+                //TMPL         sampledRequestsEnabled: true,
+                //TMPL     },
+                //TMPL },
+                //TMPL {{- end }}
+            ],
+            visibilityConfig: {
+                cloudwatchMetricsEnabled: true,
+                metricName: args.Name,
+                sampledRequestsEnabled: true,
+            },
+            //TMPL {{- if .Tags }}
+            tags: args.Tags,
+            //TMPL {{- end }}
+        },
+        //TMPL {{- if .protect }}
+        { protect: args.protect }
+        //TMPL {{- end }}
+    )
+}
+
+function properties(object: aws.wafv2.WebAcl, args: Args) {
+    return {
+        Arn: object.arn,
+        Id: object.id,
+    }
+}
@@ -0,0 +1,43 @@
+package iac
+
+import (
+	"sort"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// sortByTier orders the resources by dependency tier, the length of the longest chain of dependencies below each
+// resource, so every resource comes after its dependencies. Within a tier, resources are ordered by type then name,
+// which groups resources of the same type together for easier review of the output.
+func sortByTier(g construct.Graph) ([]construct.ResourceId, error) {
+	ordered, err := construct.ReverseTopologicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+	adj, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make(map[construct.ResourceId]int, len(ordered))
+	for _, id := range ordered {
+		tier := 0
+		for dep := range adj[id] {
+			// dependencies which haven't been assigned a tier are part of a cycle, which the topological sort
+			// already broke arbitrarily, so ignore them
+			if depTier, ok := tiers[dep]; ok && depTier+1 > tier {
+				tier = depTier + 1
+			}
+		}
+		tiers[id] = tier
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ti, tj := tiers[ordered[i]], tiers[ordered[j]]
+		if ti != tj {
+			return ti < tj
+		}
+		return construct.ResourceIdLess(ordered[i], ordered[j])
+	})
+	return ordered, nil
+}
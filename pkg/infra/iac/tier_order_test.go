@@ -0,0 +1,79 @@
+package iac
+
+import (
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph []any
+		want  []string
+	}{
+		{
+			name: "same tier sorted by type then name",
+			graph: []any{
+				"aws:sqs_queue:b",
+				"aws:s3_bucket:z",
+				"aws:sqs_queue:a",
+				"aws:s3_bucket:y",
+			},
+			want: []string{
+				"aws:s3_bucket:y",
+				"aws:s3_bucket:z",
+				"aws:sqs_queue:a",
+				"aws:sqs_queue:b",
+			},
+		},
+		{
+			name: "dependencies render first",
+			graph: []any{
+				"aws:lambda_function:fn -> aws:iam_role:fn-role",
+				"aws:lambda_function:fn -> aws:s3_bucket:bucket",
+				"aws:iam_role:fn-role -> aws:iam_policy:policy",
+				"aws:iam_policy:policy -> aws:s3_bucket:bucket",
+				"aws:sqs_queue:queue",
+			},
+			want: []string{
+				"aws:s3_bucket:bucket",
+				"aws:sqs_queue:queue",
+				"aws:iam_policy:policy",
+				"aws:iam_role:fn-role",
+				"aws:lambda_function:fn",
+			},
+		},
+		{
+			name: "tier is the longest dependency chain",
+			graph: []any{
+				"aws:lambda_function:a -> aws:vpc:vpc",
+				"aws:lambda_function:b -> aws:subnet:subnet",
+				"aws:subnet:subnet -> aws:vpc:vpc",
+			},
+			want: []string{
+				"aws:vpc:vpc",
+				"aws:lambda_function:a",
+				"aws:subnet:subnet",
+				"aws:lambda_function:b",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+
+			got, err := sortByTier(g)
+			require.NoError(t, err)
+
+			want := make([]construct.ResourceId, len(tt.want))
+			for i, id := range tt.want {
+				want[i] = graphtest.ParseId(t, id)
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+//go:build tsc
+
+package iac
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	engine "github.com/klothoplatform/klotho/pkg/engine"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	kio "github.com/klothoplatform/klotho/pkg/io"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase/reader"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"gopkg.in/yaml.v3"
+)
+
+// These tests install the generated program's dependencies and run `tsc --noEmit` against it, so they need node, npm
+// and network access. Run them with:
+//
+//	go test -tags tsc ./pkg/infra/iac
+
+func TestGeneratedTypeScriptCompiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		graph     string
+		component string
+	}{
+		{
+			name:  "lambda with rds",
+			graph: "../../engine/testdata/lambda_rds_proxy_mysql.expect.yaml",
+		},
+		{
+			name:      "lambda with rds component",
+			graph:     "../../engine/testdata/lambda_rds_proxy_mysql.expect.yaml",
+			component: "LambdaRds",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := translateGraphFile(t, tt.graph, tt.component)
+			requireTypeScriptCompiles(t, files)
+		})
+	}
+}
+
+// translateGraphFile renders the Pulumi program for the resources graph in the given file, the same way
+// `iac generate` does. If component is set, the resources are rendered as a component of that name.
+func translateGraphFile(t *testing.T, path string, component string) []kio.File {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var input construct.YamlGraph
+	if err := yaml.NewDecoder(f).Decode(&input); err != nil {
+		t.Fatalf("could not decode %s: %v", path, err)
+	}
+
+	kb, err := reader.NewKBFromFs(templates.ResourceTemplates, templates.EdgeTemplates, templates.Models)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sol := engine.NewSolution(context.Background(), kb, "", &constraints.Constraints{})
+	if err := sol.LoadGraph(input.Graph); err != nil {
+		t.Fatalf("could not load %s: %v", path, err)
+	}
+
+	plugin := Plugin{Config: &PulumiConfig{AppName: "tsc-test"}, KB: kb, ComponentName: component}
+	files, err := plugin.Translate(sol)
+	if err != nil {
+		t.Fatalf("could not translate %s: %v", path, err)
+	}
+	return files
+}
+
+// requireTypeScriptCompiles writes the files to a temporary directory, installs their dependencies and fails the test
+// if `tsc --noEmit` reports any errors. The test is skipped if npm is not available.
+func requireTypeScriptCompiles(t *testing.T, files []kio.File) {
+	t.Helper()
+	if _, err := exec.LookPath("npm"); err != nil {
+		t.Skip("npm not found, skipping TypeScript compilation check")
+	}
+
+	dir := t.TempDir()
+	if err := kio.OutputTo(files, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(name string, args ...string) {
+		cmd := exec.Command(name, args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("%s %v failed: %v\n%s", name, args, err, out)
+		}
+	}
+	run("npm", "install", "--no-audit", "--no-fund", "--ignore-scripts")
+	run("npm", "install", "--no-save", "--no-audit", "--no-fund", "typescript@5", "@types/node@18")
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.ts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("npx", "--no-install", "tsc", "--noEmit", "-p", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated TypeScript does not compile: %v\n%s\nindex.ts:\n%s", err, out, index)
+	}
+}
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAddObject_Probes(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	portRef := construct.PropertyRef{
+		Resource: construct.ResourceId{Provider: "kubernetes", Type: "service", Name: "svc"},
+		Property: "Object.spec.ports[0].targetPort",
+	}
+	res := &construct.Resource{
+		ID: construct.ResourceId{Provider: "kubernetes", Type: "deployment", Name: "app"},
+		Properties: construct.Properties{
+			"Object": map[string]any{
+				"spec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{
+									"name": "app",
+									"livenessProbe": map[string]any{
+										"httpGet":             map[string]any{"path": "/healthz", "port": 8080},
+										"initialDelaySeconds": 5,
+									},
+									"readinessProbe": map[string]any{
+										"httpGet": map[string]any{"path": "/ready", "port": portRef},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	output, err := AddObject(res)
+	require.NoError(err)
+
+	var object struct {
+		Spec struct {
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						LivenessProbe struct {
+							HttpGet struct {
+								Path string
+								Port any
+							} `yaml:"httpGet"`
+							InitialDelaySeconds int `yaml:"initialDelaySeconds"`
+						} `yaml:"livenessProbe"`
+						ReadinessProbe struct {
+							HttpGet struct {
+								Path string
+								Port any
+							} `yaml:"httpGet"`
+						} `yaml:"readinessProbe"`
+					}
+				}
+			}
+		}
+	}
+	require.NoError(yaml.Unmarshal(output.Content, &object))
+	require.Len(object.Spec.Template.Spec.Containers, 1)
+	container := object.Spec.Template.Spec.Containers[0]
+
+	assert.Equal("/healthz", container.LivenessProbe.HttpGet.Path)
+	assert.Equal(8080, container.LivenessProbe.HttpGet.Port)
+	assert.Equal(5, container.LivenessProbe.InitialDelaySeconds)
+
+	// a port which is only known at deploy time is passed through the chart's values
+	assert.Equal("/ready", container.ReadinessProbe.HttpGet.Path)
+	require.Len(output.Values, 1)
+	for key, ref := range output.Values {
+		assert.Equal(portRef, ref)
+		assert.Equal("{{ .Values."+key+" }}", container.ReadinessProbe.HttpGet.Port)
+	}
+}
@@ -0,0 +1,299 @@
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"regexp"
+	"sort"
+	"text/template"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// HclCompiler renders the resources of a graph as Terraform HCL using the factory.tf.tmpl template for each
+// resource type.
+type HclCompiler struct {
+	graph     construct.Graph
+	templates fs.FS
+
+	resourceTemplates map[string]*ResourceTemplate
+}
+
+type templateInputArgs map[string]any
+
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// RenderBody renders each of the resources, in order, each followed by a blank line.
+func (tc *HclCompiler) RenderBody(out io.Writer, resources []construct.ResourceId) error {
+	var errs error
+	for _, r := range resources {
+		errs = errors.Join(errs, tc.RenderResource(out, r))
+		if _, err := fmt.Fprintln(out); err != nil {
+			return errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func (tc *HclCompiler) RenderResource(out io.Writer, rid construct.ResourceId) error {
+	resTmpl, err := tc.ResourceTemplate(rid)
+	if err != nil {
+		return err
+	}
+	r, err := tc.graph.Vertex(rid)
+	if err != nil {
+		return err
+	}
+	if r.Imported {
+		return fmt.Errorf("resource %s is imported, which is not supported by the terraform output", rid)
+	}
+	inputs, err := tc.getInputArgs(r, resTmpl)
+	if err != nil {
+		return err
+	}
+
+	// Annotate each resource with its ID so the generated code can be traced back to the graph
+	_, err = fmt.Fprintf(out, "# %s\n", rid)
+	if err != nil {
+		return err
+	}
+	err = resTmpl.Template.Execute(out, inputs)
+	if err != nil {
+		return fmt.Errorf("could not render resource %s: %w", rid, err)
+	}
+	return nil
+}
+
+// Label returns the label of the resource's blocks, which is unique among resources of the same Terraform type.
+func Label(id construct.ResourceId) string {
+	label := id.Name
+	if id.Namespace != "" {
+		label = id.Namespace + "_" + id.Name
+	}
+	label = invalidLabelChars.ReplaceAllString(label, "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+	return label
+}
+
+// Address returns the expression which refers to the resource's main block, such as aws_lambda_function.fn
+func (tc *HclCompiler) Address(id construct.ResourceId) (hclExpr, error) {
+	tmpl, err := tc.ResourceTemplate(id)
+	if err != nil {
+		return "", err
+	}
+	addr := fmt.Sprintf("%s.%s", tmpl.Type, Label(id))
+	if tmpl.Kind == "data" {
+		addr = "data." + addr
+	}
+	return hclExpr(addr), nil
+}
+
+func (tc *HclCompiler) PropertyRefValue(ref construct.PropertyRef) (any, error) {
+	tmpl, err := tc.ResourceTemplate(ref.Resource)
+	if err != nil {
+		return nil, err
+	}
+	refRes, err := tc.graph.Vertex(ref.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping, ok := tmpl.PropertyTemplates[ref.Property]; ok {
+		inputArgs, err := tc.getInputArgs(refRes, tmpl)
+		if err != nil {
+			return nil, err
+		}
+		return tc.executeProperty(mapping, refRes.ID, inputArgs)
+	}
+
+	path, err := refRes.PropertyPath(ref.Property)
+	if err != nil {
+		return nil, err
+	}
+	if path != nil {
+		val, _ := path.Get()
+		if val == nil {
+			return nil, fmt.Errorf("property ref %s is nil", ref)
+		}
+		return tc.convertArg(val)
+	}
+	return nil, fmt.Errorf("unsupported property ref %s", ref)
+}
+
+func (tc *HclCompiler) executeProperty(
+	mapping *template.Template,
+	id construct.ResourceId,
+	inputs templateInputArgs,
+) (hclExpr, error) {
+	self, err := tc.Address(id)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	err = mapping.Execute(buf, PropertyTemplateData{Resource: id, Self: self, Input: inputs})
+	if err != nil {
+		return "", err
+	}
+	return hclExpr(buf.String()), nil
+}
+
+// convertArg converts a property value into the value used by the templates, whose string form is the value's HCL
+// expression. Unlike the Pulumi compiler, map keys are left as-is since attribute names are spelled out in the
+// templates and the only maps passed through are user data (such as tags) or JSON documents.
+func (tc *HclCompiler) convertArg(arg any) (any, error) {
+	switch arg := arg.(type) {
+	case construct.ResourceId:
+		return tc.Address(arg)
+
+	case construct.PropertyRef:
+		return tc.PropertyRefValue(arg)
+
+	case string:
+		return hclString(arg), nil
+
+	case bool, int, float64:
+		return arg, nil
+
+	case nil:
+		return nil, nil
+
+	default:
+		switch val := reflect.ValueOf(arg); val.Kind() {
+		case reflect.Slice, reflect.Array:
+			list := make(hclList, 0, val.Len())
+			for i := 0; i < val.Len(); i++ {
+				if !val.Index(i).IsValid() || val.Index(i).IsZero() {
+					continue
+				}
+				output, err := tc.convertArg(val.Index(i).Interface())
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, output)
+			}
+			return list, nil
+
+		case reflect.Map:
+			m := make(hclMap, val.Len())
+			for _, key := range val.MapKeys() {
+				if !val.MapIndex(key).IsValid() || val.MapIndex(key).IsZero() {
+					continue
+				}
+				keyStr, found := key.Interface().(string)
+				if !found {
+					return nil, fmt.Errorf("map key is not a string")
+				}
+				output, err := tc.convertArg(val.MapIndex(key).Interface())
+				if err != nil {
+					return nil, err
+				}
+				m[keyStr] = output
+			}
+			return m, nil
+
+		case reflect.Struct:
+			if hashset, ok := val.Interface().(set.HashedSet[string, any]); ok {
+				return tc.convertArg(hashset.ToSlice())
+			}
+			fallthrough
+
+		default:
+			// JSON is valid HCL for the remaining primitive values
+			b, err := json.Marshal(arg)
+			if err != nil {
+				return nil, err
+			}
+			return hclExpr(b), nil
+		}
+	}
+}
+
+func (tc *HclCompiler) getInputArgs(r *construct.Resource, template *ResourceTemplate) (templateInputArgs, error) {
+	var errs error
+	inputs := make(templateInputArgs, len(r.Properties)+4) // +4 for Name, Label, Self and dependsOn
+	selfReferences := make(map[string]construct.PropertyRef)
+
+	for name, value := range r.Properties {
+		if ref, ok := value.(construct.PropertyRef); ok && ref.Resource == r.ID {
+			selfReferences[name] = ref
+			continue
+		}
+		argValue, err := tc.convertArg(value)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not convert arg %q: %w", name, err))
+			continue
+		}
+		if argValue != nil {
+			inputs[name] = argValue
+		}
+	}
+
+	self, err := tc.Address(r.ID)
+	if err != nil {
+		return templateInputArgs{}, err
+	}
+	inputs["Name"] = hclString(r.ID.Name)
+	inputs["Label"] = Label(r.ID)
+	inputs["Self"] = self
+
+	for name, value := range selfReferences {
+		mapping, ok := template.PropertyTemplates[value.Property]
+		if !ok {
+			errs = errors.Join(errs, fmt.Errorf("could not find mapping for self-reference %q", name))
+			continue
+		}
+		result, err := tc.executeProperty(mapping, r.ID, inputs)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not execute self-reference %q: %w", name, err))
+			continue
+		}
+		inputs[name] = result
+	}
+	if errs != nil {
+		return templateInputArgs{}, errs
+	}
+
+	downstream, err := construct.DirectDownstreamDependencies(tc.graph, r.ID)
+	if err != nil {
+		return templateInputArgs{}, err
+	}
+	var dependsOn []string
+	for _, dep := range downstream {
+		depTmpl, err := tc.ResourceTemplate(dep)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		// data sources are read before any resources are created, so there's nothing to wait on
+		if depTmpl.Kind == "data" {
+			continue
+		}
+		addr, err := tc.Address(dep)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		dependsOn = append(dependsOn, string(addr))
+	}
+	if errs != nil {
+		return templateInputArgs{}, errs
+	}
+	sort.Strings(dependsOn)
+	if len(dependsOn) > 0 {
+		list := make(hclList, len(dependsOn))
+		for i, dep := range dependsOn {
+			list[i] = hclExpr(dep)
+		}
+		inputs["dependsOn"] = list
+	}
+
+	return inputs, nil
+}
@@ -0,0 +1,243 @@
+package terraform
+
+import (
+	"bytes"
+	"io/fs"
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderResource(t *testing.T) {
+	role := &construct.Resource{
+		ID: construct.ResourceId{Provider: "aws", Type: "iam_role", Name: "fn-ExecutionRole"},
+		Properties: construct.Properties{
+			"AssumeRolePolicyDoc": map[string]any{
+				"Version": "2012-10-17",
+				"Statement": []any{map[string]any{
+					"Action":    []any{"sts:AssumeRole"},
+					"Effect":    "Allow",
+					"Principal": map[string]any{"Service": []any{"lambda.amazonaws.com"}},
+				}},
+			},
+			"InlinePolicies": []any{map[string]any{
+				"Name": "bucket-policy",
+				"Policy": map[string]any{
+					"Version": "2012-10-17",
+					"Statement": []any{map[string]any{
+						"Action": []any{"s3:GetObject"},
+						"Effect": "Allow",
+						"Resource": []any{
+							construct.PropertyRef{Resource: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"}, Property: "Arn"},
+							construct.PropertyRef{Resource: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"}, Property: "AllBucketDirectory"},
+						},
+					}},
+				},
+			}},
+			"ManagedPolicies": []any{"arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"},
+		},
+	}
+	lambda := func(code string) *construct.Resource {
+		return &construct.Resource{
+			ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+			Properties: construct.Properties{
+				"Code":          code,
+				"Handler":       "index.handler",
+				"Runtime":       "nodejs20.x",
+				"ExecutionRole": role.ID,
+				"MemorySize":    512,
+				"Timeout":       180,
+				"EnvironmentVariables": map[string]any{
+					"BUCKET_NAME": construct.PropertyRef{
+						Resource: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+						Property: "Bucket",
+					},
+				},
+				"Tags": map[string]any{"RESOURCE_NAME": "fn"},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		graph    []any
+		render   string
+		contains []string
+		wantErr  bool
+	}{
+		{
+			name:   "lambda function from a zip file",
+			graph:  []any{lambda("app.zip"), role, "aws:s3_bucket:bucket", "aws:lambda_function:fn -> aws:iam_role:fn-ExecutionRole"},
+			render: "aws:lambda_function:fn",
+			contains: []string{
+				`# aws:lambda_function:fn`,
+				`resource "aws_lambda_function" "fn" {`,
+				`function_name = "fn"`,
+				`role = aws_iam_role.fn-ExecutionRole.arn`,
+				`filename = "app.zip"`,
+				`source_code_hash = filebase64sha256("app.zip")`,
+				`memory_size = 512`,
+				`variables = {BUCKET_NAME = aws_s3_bucket.bucket.bucket}`,
+				`tags = {RESOURCE_NAME = "fn"}`,
+				`depends_on = [aws_iam_role.fn-ExecutionRole]`,
+			},
+		},
+		{
+			name:   "lambda function from a folder",
+			graph:  []any{lambda("src/fn"), role, "aws:s3_bucket:bucket"},
+			render: "aws:lambda_function:fn",
+			contains: []string{
+				`filename = data.archive_file.fn_code.output_path`,
+				`data "archive_file" "fn_code" {`,
+				`source_dir = "src/fn"`,
+			},
+		},
+		{
+			name:    "lambda function from a url",
+			graph:   []any{lambda("https://example.com/fn.zip"), role, "aws:s3_bucket:bucket"},
+			render:  "aws:lambda_function:fn",
+			wantErr: true,
+		},
+		{
+			name:   "iam role",
+			graph:  []any{role, "aws:s3_bucket:bucket"},
+			render: "aws:iam_role:fn-ExecutionRole",
+			contains: []string{
+				`resource "aws_iam_role" "fn-ExecutionRole" {`,
+				`assume_role_policy = jsonencode({Statement = [{Action = ["sts:AssumeRole"], Effect = "Allow", Principal = {Service = ["lambda.amazonaws.com"]}}], Version = "2012-10-17"})`,
+				`name = "bucket-policy"`,
+				`Resource = [aws_s3_bucket.bucket.arn, "${aws_s3_bucket.bucket.arn}/*"]`,
+				`managed_policy_arns = ["arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"]`,
+			},
+		},
+		{
+			name: "s3 bucket with encryption",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"ForceDestroy": true,
+						"SSEAlgorithm": "aws:kms",
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`resource "aws_s3_bucket" "bucket" {`,
+				`force_destroy = true`,
+				`resource "aws_s3_bucket_server_side_encryption_configuration" "bucket" {`,
+				`bucket = aws_s3_bucket.bucket.id`,
+				`sse_algorithm = "aws:kms"`,
+			},
+		},
+		{
+			name: "subnet in an availability zone",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+					Properties: construct.Properties{
+						"CidrBlock":          "10.0.0.0/16",
+						"EnableDnsHostnames": true,
+						"EnableDnsSupport":   true,
+					},
+				},
+				&construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "availability_zone", Name: "az-1"},
+					Properties: construct.Properties{"Index": 1},
+				},
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "subnet", Namespace: "vpc", Name: "subnet1"},
+					Properties: construct.Properties{
+						"Vpc":       construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"},
+						"CidrBlock": "10.0.128.0/18",
+						"AvailabilityZone": construct.PropertyRef{
+							Resource: construct.ResourceId{Provider: "aws", Type: "availability_zone", Name: "az-1"},
+							Property: "Name",
+						},
+					},
+				},
+				"aws:subnet:vpc:subnet1 -> aws:vpc:vpc",
+				"aws:subnet:vpc:subnet1 -> aws:availability_zone:az-1",
+			},
+			render: "aws:subnet:vpc:subnet1",
+			contains: []string{
+				`resource "aws_subnet" "vpc_subnet1" {`,
+				`vpc_id = aws_vpc.vpc.id`,
+				`availability_zone = data.aws_availability_zones.az-1.names[1]`,
+				`depends_on = [aws_vpc.vpc]`,
+			},
+		},
+		{
+			name: "strings are escaped",
+			graph: []any{
+				&construct.Resource{
+					ID: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+					Properties: construct.Properties{
+						"Tags": map[string]any{"template": `${not.a.ref} "quoted"`, "with space": "x"},
+					},
+				},
+			},
+			render: "aws:s3_bucket:bucket",
+			contains: []string{
+				`tags = {template = "$${not.a.ref} \"quoted\"", "with space" = "x"}`,
+			},
+		},
+		{
+			name:    "unsupported resource type",
+			graph:   []any{"aws:sqs_queue:queue"},
+			render:  "aws:sqs_queue:queue",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+			tc := newTestCompiler(t, g)
+
+			var rid construct.ResourceId
+			require.NoError(rid.Parse(tt.render))
+
+			buf := new(bytes.Buffer)
+			err := tc.RenderResource(buf, rid)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			for _, want := range tt.contains {
+				assert.Contains(buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "aws:lambda_function:fn", want: "fn"},
+		{id: "aws:iam_role:fn-ExecutionRole", want: "fn-ExecutionRole"},
+		{id: "aws:subnet:vpc:subnet1", want: "vpc_subnet1"},
+		{id: "aws:availability_zone:0", want: "_0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, Label(graphtest.ParseId(t, tt.id)))
+		})
+	}
+}
+
+// newTestCompiler creates an [HclCompiler] for the graph using the standard templates.
+func newTestCompiler(t *testing.T, g construct.Graph) *HclCompiler {
+	t.Helper()
+	templatesFS, err := fs.Sub(standardTemplates, "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &HclCompiler{graph: g, templates: templatesFS}
+}
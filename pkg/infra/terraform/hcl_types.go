@@ -0,0 +1,86 @@
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type (
+	// hclString is a quoted HCL string, but evaluates as false-y in a template if the string is empty.
+	// See the Pulumi compiler's templateString for an example.
+	hclString string
+
+	// hclExpr is an HCL expression, such as a reference to another resource's attribute, rendered as-is.
+	hclExpr string
+
+	hclList []any
+
+	hclMap map[string]any
+)
+
+var hclIdentifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// hclEscaper escapes the characters that are special in HCL quoted strings. Template sequences (`${` and `%{`) are
+// escaped so that literal values are never interpolated.
+var hclEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+func (s hclString) String() string {
+	return `"` + hclEscaper.Replace(string(s)) + `"`
+}
+
+func (e hclExpr) String() string {
+	return string(e)
+}
+
+// Interpolation returns the expression as a template interpolation, for use inside of a quoted string.
+func (e hclExpr) Interpolation() string {
+	return "${" + string(e) + "}"
+}
+
+func (l hclList) String() string {
+	buf := strings.Builder{}
+	buf.WriteRune('[')
+	for i, v := range l {
+		fmt.Fprintf(&buf, "%v", v)
+		if i < len(l)-1 {
+			buf.WriteString(", ")
+		}
+	}
+	buf.WriteRune(']')
+	return buf.String()
+}
+
+// String renders the map as an object constructor with its keys sorted, so the output is stable between runs.
+// Keys which are not valid identifiers are quoted.
+func (m hclMap) String() string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf := strings.Builder{}
+	buf.WriteRune('{')
+	for i, k := range keys {
+		key := k
+		if !hclIdentifierPattern.MatchString(k) {
+			key = hclString(k).String()
+		}
+		fmt.Fprintf(&buf, "%s = %v", key, m[k])
+		if i < len(keys)-1 {
+			buf.WriteString(", ")
+		}
+	}
+	buf.WriteRune('}')
+	return buf.String()
+}
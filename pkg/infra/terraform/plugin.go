@@ -0,0 +1,88 @@
+package terraform
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	kio "github.com/klothoplatform/klotho/pkg/io"
+)
+
+// Plugin generates Terraform HCL for the solution, as an alternative to the Pulumi TypeScript output. Only the
+// resource types with a factory.tf.tmpl template are supported.
+type Plugin struct{}
+
+func (p Plugin) Name() string {
+	return "terraform"
+}
+
+var (
+	//go:embed templates/providers.tf templates/aws/*/factory.tf.tmpl
+	standardTemplates embed.FS
+)
+
+func (p Plugin) Translate(sol solution.Solution) ([]kio.File, error) {
+	templatesFS, err := fs.Sub(standardTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+	tc := &HclCompiler{
+		graph:     sol.DeploymentGraph(),
+		templates: templatesFS,
+	}
+
+	resources, err := construct.ReverseTopologicalSort(tc.graph)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer) // Don't use a pooled buffer since RawFile uses the byte array
+	if err := tc.RenderBody(buf, resources); err != nil {
+		return nil, err
+	}
+	if err := renderOutputs(tc, buf, sol.Outputs()); err != nil {
+		return nil, err
+	}
+
+	providers, err := fs.ReadFile(templatesFS, "providers.tf")
+	if err != nil {
+		return nil, err
+	}
+
+	return []kio.File{
+		&kio.RawFile{FPath: "providers.tf", Content: providers},
+		&kio.RawFile{FPath: "main.tf", Content: buf.Bytes()},
+	}, nil
+}
+
+func renderOutputs(tc *HclCompiler, buf *bytes.Buffer, outputs map[string]construct.Output) error {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		val, err := outputValue(tc, outputs[name])
+		if err != nil {
+			return fmt.Errorf("could not render output %s: %w", name, err)
+		}
+		fmt.Fprintf(buf, "output %s {\n  value = %v\n}\n\n", hclString(name), val)
+	}
+	return nil
+}
+
+func outputValue(tc *HclCompiler, output construct.Output) (any, error) {
+	if !output.Ref.IsZero() {
+		return tc.PropertyRefValue(output.Ref)
+	}
+	val, err := json.Marshal(output.Value)
+	if err != nil {
+		return nil, err
+	}
+	return hclExpr(val), nil
+}
@@ -0,0 +1,58 @@
+package terraform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/engine/debug"
+	kio "github.com/klothoplatform/klotho/pkg/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlugin_Translate(t *testing.T) {
+	main := engine.EngineMain{}
+	require.NoError(t, main.AddEngine())
+
+	req := &engine.SolveRequest{}
+	for _, id := range []string{"aws:lambda_function:fn", "aws:s3_bucket:bucket"} {
+		req.Constraints.Application = append(req.Constraints.Application, constraints.ApplicationConstraint{
+			Operator: constraints.AddConstraintOperator,
+			Node:     graphtest.ParseId(t, id),
+		})
+	}
+	req.Constraints.Edges = append(req.Constraints.Edges, constraints.EdgeConstraint{
+		Operator: constraints.MustExistConstraintOperator,
+		Target: constraints.Edge{
+			Source: graphtest.ParseId(t, "aws:lambda_function:fn"),
+			Target: graphtest.ParseId(t, "aws:s3_bucket:bucket"),
+		},
+	})
+	// keep the path selection diagrams out of the source tree
+	ctx := debug.WithDebugDir(context.Background(), t.TempDir())
+	sol, err := main.Engine.Run(ctx, req)
+	require.NoError(t, err)
+
+	files, err := Plugin{}.Translate(sol)
+	require.NoError(t, err)
+
+	contents := make(map[string]string)
+	for _, f := range files {
+		contents[f.Path()] = string(f.(*kio.RawFile).Content)
+	}
+	mainTf := contents["main.tf"]
+	for _, block := range []string{
+		`resource "aws_lambda_function" "fn"`,
+		`resource "aws_s3_bucket" "bucket"`,
+		`resource "aws_ecr_repository" "fn-image-ecr_repo"`,
+		`resource "docker_registry_image" "fn-image"`,
+		`name = "${aws_ecr_repository.fn-image-ecr_repo.repository_url}:latest"`,
+		`image_uri = "${docker_registry_image.fn-image.name}@${docker_registry_image.fn-image.sha256_digest}"`,
+	} {
+		assert.Contains(t, mainTf, block)
+	}
+	assert.Contains(t, contents["providers.tf"], `kreuzwerker/docker`)
+}
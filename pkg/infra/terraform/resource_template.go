@@ -0,0 +1,119 @@
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"text/template"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+type (
+	// ResourceTemplate is a parsed factory.tf.tmpl. The main template renders the resource's blocks and each
+	// template defined in the file (via `{{ define "Arn" }}`) renders the HCL expression for the property of that
+	// name, the counterpart of the `properties` function in the Pulumi factories.
+	ResourceTemplate struct {
+		Name string
+		Path string
+		// Kind is the kind of the resource's main block, either "resource" or "data"
+		Kind string
+		// Type is the Terraform type of the resource's main block, such as aws_lambda_function
+		Type              string
+		Template          *template.Template
+		PropertyTemplates map[string]*template.Template
+	}
+
+	// PropertyTemplateData is the data passed to a resource's property templates.
+	PropertyTemplateData struct {
+		Resource construct.ResourceId
+		// Self is the address of the resource's main block, such as aws_lambda_function.fn
+		Self  hclExpr
+		Input templateInputArgs
+	}
+)
+
+var mainBlockPattern = regexp.MustCompile(`(?m)^(resource|data) "([a-z0-9_]+)"`)
+
+func (tc *HclCompiler) ResourceTemplate(id construct.ResourceId) (*ResourceTemplate, error) {
+	typeName := id.QualifiedTypeName()
+	if tc.resourceTemplates == nil {
+		tc.resourceTemplates = make(map[string]*ResourceTemplate)
+	}
+	tmpl, ok := tc.resourceTemplates[typeName]
+	if ok {
+		return tmpl, nil
+	}
+	path := id.Provider + "/" + id.Type
+	f, err := tc.templates.Open(path + `/factory.tf.tmpl`)
+	if err != nil {
+		return nil, fmt.Errorf("could not find terraform template for %s: %w", typeName, err)
+	}
+	defer f.Close()
+	tmpl, err = ParseTemplate(typeName, f)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse terraform template for %s: %w", typeName, err)
+	}
+	tmpl.Path = path
+	tc.resourceTemplates[typeName] = tmpl
+	return tmpl, nil
+}
+
+func ParseTemplate(name string, r io.Reader) (*ResourceTemplate, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	main := mainBlockPattern.FindSubmatch(contents)
+	if main == nil {
+		return nil, fmt.Errorf("template %s has no resource or data block", name)
+	}
+
+	t, err := template.New(name).Funcs(template.FuncMap{
+		"matches": func(pattern string, value hclString) (bool, error) {
+			return regexp.MatchString(pattern, string(value))
+		},
+		// attr returns the attribute of a referenced resource, or of each resource for a list of them
+		"attr": attr,
+		"fail": func(msg string) (string, error) {
+			return "", errors.New(msg)
+		},
+	}).Parse(string(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &ResourceTemplate{
+		Name:              name,
+		Kind:              string(main[1]),
+		Type:              string(main[2]),
+		Template:          t,
+		PropertyTemplates: make(map[string]*template.Template),
+	}
+	for _, defined := range t.Templates() {
+		if defined.Name() != name {
+			rt.PropertyTemplates[defined.Name()] = defined
+		}
+	}
+	return rt, nil
+}
+
+func attr(val any, name string) (any, error) {
+	switch val := val.(type) {
+	case hclExpr:
+		return hclExpr(fmt.Sprintf("%s.%s", val, name)), nil
+
+	case hclList:
+		list := make(hclList, len(val))
+		for i, item := range val {
+			v, err := attr(item, name)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("cannot get attribute %q of %v (%T)", name, val, val)
+}
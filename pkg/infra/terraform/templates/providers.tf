@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    archive = {
+      source  = "hashicorp/archive"
+      version = "~> 2.4"
+    }
+    docker = {
+      source  = "kreuzwerker/docker"
+      version = "~> 3.0"
+    }
+  }
+}
+
+provider "aws" {}
+
+data "aws_ecr_authorization_token" "token" {}
+
+# used to push the ECR images
+provider "docker" {
+  registry_auth {
+    address  = replace(data.aws_ecr_authorization_token.token.proxy_endpoint, "https://", "")
+    username = data.aws_ecr_authorization_token.token.user_name
+    password = data.aws_ecr_authorization_token.token.password
+  }
+}
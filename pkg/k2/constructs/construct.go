@@ -25,6 +25,9 @@ type (
 		InitialGraph       construct.Graph
 		Bindings           []*Binding
 		Solution           solution.Solution
+		// NamePrefix, if set, is prepended to the names of the resources the construct creates
+		// so that they are grouped together (eg, my-app-api-*)
+		NamePrefix string
 	}
 
 	Resource struct {
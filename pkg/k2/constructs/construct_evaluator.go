@@ -140,6 +140,9 @@ func (ce *ConstructEvaluator) evaluateConstruct(constructUrn model.URN, state mo
 	if err != nil {
 		return nil, fmt.Errorf("could not create construct: %w", err)
 	}
+	if prefix, ok := cState.Options["name_prefix"].(string); ok {
+		c.NamePrefix = prefix
+	}
 	ce.Constructs.Set(constructUrn, c)
 
 	if err = ce.initBindings(c, state); err != nil {
@@ -602,6 +605,11 @@ func (ce *ConstructEvaluator) resolveResource(dv *DynamicValueData, key string,
 			Namespace: resTmpl.Namespace,
 			Name:      resTmpl.Name,
 		}
+		// The prefix is part of the ID from the start, so every reference to the resource (including from other
+		// constructs' bindings) resolves to the prefixed name.
+		if prefix := dv.currentOwner.GetConstruct().NamePrefix; prefix != "" {
+			id.Name = fmt.Sprintf("%s-%s", prefix, id.Name)
+		}
 		if resource.Id == (construct.ResourceId{}) {
 			resource.Id = id
 		} else if resource.Id != id {
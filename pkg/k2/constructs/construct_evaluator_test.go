@@ -1,6 +1,7 @@
 package constructs
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/k2/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type stringerStructInput struct {
@@ -545,3 +547,73 @@ func TestNewConstruct(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveResource_NamePrefix(t *testing.T) {
+	ce := &ConstructEvaluator{}
+	api := &Construct{
+		URN:        model.URN{ResourceID: "api"},
+		Resources:  map[string]*Resource{},
+		NamePrefix: "my-app",
+	}
+	props := map[string]any{"Runtime": "python3.12"}
+
+	r, err := ce.resolveResource(&DynamicValueData{currentOwner: api}, "LambdaFunction", template.ResourceTemplate{
+		Type:       "aws:lambda_function",
+		Name:       "api-function",
+		Properties: props,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	api.SetResource("LambdaFunction", r)
+	fn := construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "my-app-api-function"}
+	assert.Equal(t, fn, r.Id)
+
+	// bindings from another construct resolve the reference to the prefixed resource
+	ref, err := ce.marshalRef(api, template.ResourceRef{ResourceKey: "LambdaFunction", Property: "Arn"})
+	assert.NoError(t, err)
+	assert.Equal(t, construct.PropertyRef{Resource: fn, Property: "Arn"}, ref)
+
+	// resources created by a binding are prefixed by the binding's owner
+	b := &Binding{Owner: api, Resources: map[string]*Resource{}}
+	r, err = ce.resolveResource(&DynamicValueData{currentOwner: b}, "Permission", template.ResourceTemplate{
+		Type: "aws:lambda_permission",
+		Name: "api-permission",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-app-api-permission", r.Id.Name)
+
+	assert.Equal(t, map[string]any{"Runtime": "python3.12"}, props, "the template's properties are not modified")
+}
+
+func TestEvaluate_RegisteredTemplate(t *testing.T) {
+	_, err := template.RegisterConstructTemplate([]byte(`
+id: acme.queue.Mailbox
+version: 1.0.0
+description: A queue with a dead letter queue
+resources:
+  Queue:
+    type: aws:sqs_queue
+    name: ${inputs:Name}-queue
+  DeadLetterQueue:
+    type: aws:sqs_queue
+    name: ${inputs:Name}-dlq
+`))
+	require.NoError(t, err)
+
+	urn, err := model.ParseURN("urn:accountid:project:dev::construct/acme.queue.Mailbox:inbox")
+	require.NoError(t, err)
+	ce, err := NewConstructEvaluator(nil, nil)
+	require.NoError(t, err)
+
+	req, err := ce.Evaluate(*urn, model.State{
+		Constructs: map[string]model.ConstructState{"inbox": {URN: urn}},
+	}, context.Background())
+	require.NoError(t, err)
+
+	var ids []string
+	for _, c := range req.Constraints.Application {
+		ids = append(ids, c.Node.String())
+	}
+	assert.ElementsMatch(t, []string{"aws:sqs_queue:inbox-queue", "aws:sqs_queue:inbox-dlq"}, ids)
+}
@@ -43,9 +43,9 @@ func LoadConstructTemplate(id property.ConstructType) (ConstructTemplate, error)
 		return ConstructTemplate{}, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	var ct ConstructTemplate
-	if err := yaml.Unmarshal(fileContent, &ct); err != nil {
-		return ConstructTemplate{}, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	ct, err := parseConstructTemplate(fileContent)
+	if err != nil {
+		return ConstructTemplate{}, err
 	}
 
 	cachedConstructs[ct.Id] = ct
@@ -64,14 +64,8 @@ func LoadBindingTemplate(owner property.ConstructType, from property.ConstructTy
 	// if the owner is to, the key is from_<from_name>
 	// this is because the binding template is stored in the directory of the owner
 	// and each binding may have a separate template file for both the from and to constructs
-	var bindingKey string
-	if owner == from {
-		bindingKey = "to_" + to.String()
-	} else {
-		bindingKey = "from_" + from.String()
-	}
-
-	cacheKey := fmt.Sprintf("%s/%s", owner.String(), bindingKey)
+	bindingKey := getBindingKey(owner, from, to)
+	cacheKey := getBindingCacheKey(owner, bindingKey)
 
 	if ct, ok := cachedBindings[cacheKey]; ok {
 		return ct, nil
@@ -101,6 +95,72 @@ func LoadBindingTemplate(owner property.ConstructType, from property.ConstructTy
 	return ct, nil
 }
 
+// RegisterConstructTemplate parses the YAML construct template in content and registers it so that it can be
+// loaded by [LoadConstructTemplate]. This allows consumers to add constructs (including ones outside of the
+// built-in "klotho." packages) without them being embedded in this package.
+func RegisterConstructTemplate(content []byte) (ConstructTemplate, error) {
+	ct, err := parseConstructTemplate(content)
+	if err != nil {
+		return ConstructTemplate{}, err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := cachedConstructs[ct.Id]; ok {
+		return ConstructTemplate{}, fmt.Errorf("construct template %s already registered", ct.Id.String())
+	}
+	cachedConstructs[ct.Id] = ct
+
+	return ct, nil
+}
+
+// RegisterBindingTemplate parses the YAML binding template in content and registers it for the owner construct
+// so that it can be loaded by [LoadBindingTemplate].
+func RegisterBindingTemplate(owner property.ConstructType, content []byte) (BindingTemplate, error) {
+	var bt BindingTemplate
+	if err := yaml.Unmarshal(content, &bt); err != nil {
+		return BindingTemplate{}, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	}
+	if owner != bt.From && owner != bt.To {
+		return BindingTemplate{}, fmt.Errorf("owner must be either from or to")
+	}
+
+	cacheKey := getBindingCacheKey(owner, getBindingKey(owner, bt.From, bt.To))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := cachedBindings[cacheKey]; ok {
+		return BindingTemplate{}, fmt.Errorf("binding template %s (%s -> %s) already registered", owner.String(), bt.From.String(), bt.To.String())
+	}
+	cachedBindings[cacheKey] = bt
+
+	return bt, nil
+}
+
+// parseConstructTemplate parses the YAML construct template in content. The template must have an id, since that is
+// what it is cached and loaded by.
+func parseConstructTemplate(content []byte) (ConstructTemplate, error) {
+	var ct ConstructTemplate
+	if err := yaml.Unmarshal(content, &ct); err != nil {
+		return ConstructTemplate{}, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	}
+	if ct.Id.Package == "" || ct.Id.Name == "" {
+		return ConstructTemplate{}, fmt.Errorf("construct template has no id")
+	}
+	return ct, nil
+}
+
+func getBindingKey(owner property.ConstructType, from property.ConstructType, to property.ConstructType) string {
+	if owner == from {
+		return "to_" + to.String()
+	}
+	return "from_" + from.String()
+}
+
+func getBindingCacheKey(owner property.ConstructType, bindingKey string) string {
+	return fmt.Sprintf("%s/%s", owner.String(), bindingKey)
+}
+
 func getConstructTemplateDir(id property.ConstructType) (string, error) {
 	// trim the klotho package prefix
 	parts := strings.SplitN(id.Package, ".", 2)
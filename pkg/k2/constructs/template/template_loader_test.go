@@ -0,0 +1,67 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/k2/constructs/template/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterConstructTemplate(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	archive := property.ConstructType{Package: "acme.storage", Name: "Archive"}
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(cachedConstructs, archive)
+		delete(cachedBindings, getBindingCacheKey(archive, "to_klotho.aws.Bucket"))
+	})
+
+	_, err := LoadConstructTemplate(archive)
+	require.Error(err, "unregistered custom construct should not load")
+
+	_, err = RegisterConstructTemplate([]byte(`
+id: acme.storage.Archive
+version: 1.0.0
+description: An archive bucket with a lifecycle policy
+resources:
+  Bucket:
+    type: aws:s3_bucket
+    name: ${inputs:Name}-archive
+  Policy:
+    type: aws:s3_bucket_policy
+    name: ${inputs:Name}-archive-policy
+`))
+	require.NoError(err)
+
+	ct, err := LoadConstructTemplate(archive)
+	require.NoError(err)
+	assert.Equal(archive, ct.Id)
+
+	var resources []string
+	it := ct.ResourcesIterator()
+	it.ForEach(func(key string, rt ResourceTemplate) error {
+		resources = append(resources, key+"="+rt.Type)
+		return nil
+	})
+	assert.Equal([]string{"Bucket=aws:s3_bucket", "Policy=aws:s3_bucket_policy"}, resources)
+
+	_, err = RegisterConstructTemplate([]byte(`id: acme.storage.Archive`))
+	assert.Error(err, "duplicate registration should fail")
+
+	_, err = RegisterConstructTemplate([]byte(`description: A construct without an id`))
+	assert.EqualError(err, "construct template has no id")
+
+	bucket := property.ConstructType{Package: "klotho.aws", Name: "Bucket"}
+	_, err = RegisterBindingTemplate(archive, []byte(`
+from: acme.storage.Archive
+to: klotho.aws.Bucket
+`))
+	require.NoError(err)
+
+	bt, err := LoadBindingTemplate(archive, archive, bucket)
+	require.NoError(err)
+	assert.Equal(bucket, bt.To)
+}
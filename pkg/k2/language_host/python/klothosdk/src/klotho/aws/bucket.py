@@ -1,4 +1,4 @@
-from typing import Optional, overload, Any
+from typing import Optional, overload, Any, List
 
 from klotho.construct import (
     ConstructOptions,
@@ -6,7 +6,7 @@ from klotho.construct import (
     Construct,
     Binding,
 )
-from klotho.output import Input, Output
+from klotho.output import Input, MappingInput, Output
 from klotho.type_util import set_field, get_field, get_output
 
 
@@ -16,6 +16,8 @@ class BucketArgs:
         index_document: Optional[Input[str]] = None,
         sse_algorithm: Optional[Input[str]] = None,
         force_destroy: Optional[Input[bool]] = None,
+        versioning: Optional[Input[bool]] = None,
+        lifecycle_rules: Optional[Input[List[MappingInput[Any]]]] = None,
     ):
         if index_document is not None:
             set_field(self, "index_document", index_document)
@@ -23,6 +25,10 @@ class BucketArgs:
             set_field(self, "sse_algorithm", sse_algorithm)
         if force_destroy is not None:
             set_field(self, "force_destroy", force_destroy)
+        if versioning is not None:
+            set_field(self, "versioning", versioning)
+        if lifecycle_rules is not None:
+            set_field(self, "lifecycle_rules", lifecycle_rules)
 
     @property
     def index_document(self) -> Optional[Input[str]]:
@@ -48,6 +54,22 @@ class BucketArgs:
     def force_destroy(self, value: Optional[Input[bool]]) -> None:
         set_field(self, "force_destroy", value)
 
+    @property
+    def versioning(self) -> Optional[Input[bool]]:
+        return get_field(self, "versioning")
+
+    @versioning.setter
+    def versioning(self, value: Optional[Input[bool]]) -> None:
+        set_field(self, "versioning", value)
+
+    @property
+    def lifecycle_rules(self) -> Optional[Input[List[MappingInput[Any]]]]:
+        return get_field(self, "lifecycle_rules")
+
+    @lifecycle_rules.setter
+    def lifecycle_rules(self, value: Optional[Input[List[MappingInput[Any]]]]) -> None:
+        set_field(self, "lifecycle_rules", value)
+
 
 class Bucket(Construct):
 
@@ -63,6 +85,8 @@ class Bucket(Construct):
         index_document: Optional[Input[str]] = None,
         sse_algorithm: Optional[Input[str]] = None,
         force_destroy: Optional[Input[bool]] = None,
+        versioning: Optional[Input[bool]] = None,
+        lifecycle_rules: Optional[Input[List[MappingInput[Any]]]] = None,
         opts: Optional[ConstructOptions] = None,
     ): ...
 
@@ -80,6 +104,8 @@ class Bucket(Construct):
         index_document: Optional[Input[str]] = None,
         sse_algorithm: Optional[Input[str]] = None,
         force_destroy: Optional[Input[bool]] = None,
+        versioning: Optional[Input[bool]] = None,
+        lifecycle_rules: Optional[Input[List[MappingInput[Any]]]] = None,
     ):
         super().__init__(
             name,
@@ -88,6 +114,8 @@ class Bucket(Construct):
                 "IndexDocument": index_document,
                 "SseAlgorithm": sse_algorithm,
                 "ForceDestroy": force_destroy,
+                "Versioning": versioning,
+                "LifecycleRules": lifecycle_rules,
             },
             opts=opts,
         )
@@ -16,7 +16,8 @@ class DynamoDBArgs:
                  range_key: Optional[Input[str]] = None,
                  global_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None, 
                  local_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None,
-                 tags: Optional[Input[MappingInput[str]]] = None
+                 tags: Optional[Input[MappingInput[str]]] = None,
+                 table_class: Optional[Input[str]] = None,
                 ):
         set_field(self, "attributes", attributes)
         set_field(self, "hash_key", hash_key)
@@ -30,6 +31,8 @@ class DynamoDBArgs:
             set_field(self, "local_secondary_indexes", local_secondary_indexes)
         if tags is not None:
             set_field(self, "tags", tags)
+        if table_class is not None:
+            set_field(self, "table_class", table_class)
 
     def _get_property(self, name: str):
         return get_field(self, name)
@@ -93,6 +96,14 @@ class DynamoDBArgs:
     def tags(self, value: Optional[Input[MappingInput[str]]]) -> None:
         self._set_property("tags", value)
 
+    @property
+    def table_class(self) -> Optional[Input[str]]:
+        return self._get_property("table_class")
+
+    @table_class.setter
+    def table_class(self, value: Optional[Input[str]]) -> None:
+        self._set_property("table_class", value)
+
 class DynamoDB(Construct):
     """Represents a DynamoDB table construct in AWS."""
 
@@ -112,6 +123,7 @@ class DynamoDB(Construct):
         global_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None,  
         local_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None,  
         tags: Optional[MappingInput[str]] = None,
+        table_class: Optional[Input[str]] = None,
         opts: Optional[ConstructOptions] = None,
     ): ...
 
@@ -132,11 +144,14 @@ class DynamoDB(Construct):
         global_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None,  
         local_secondary_indexes: Optional[Input[List[MappingInput[str]]]] = None,  
         tags: Optional[Input[MappingInput[str]]] = None,
+        table_class: Optional[Input[str]] = None,
         opts: Optional[ConstructOptions] = None,
     ):
         """Internal initializer for DynamoDB."""
         if billing_mode is None:
             billing_mode = "PAY_PER_REQUEST"
+        if table_class is None:
+            table_class = "STANDARD"
 
         super().__init__(
             name,
@@ -149,6 +164,7 @@ class DynamoDB(Construct):
                 "GlobalSecondaryIndexes": global_secondary_indexes,  
                 "LocalSecondaryIndexes": local_secondary_indexes,   
                 "Tags": tags,
+                "TableClass": table_class,
             },
             opts=opts,
         )
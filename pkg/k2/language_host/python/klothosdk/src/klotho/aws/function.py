@@ -28,6 +28,7 @@ class FunctionArgs:
         runtime: Optional[Input[str]] = None,
         timeout: Optional[Input[int]] = None,
         memory_size: Optional[Input[int]] = None,
+        architecture: Optional[Input[str]] = None,
         environment_variables: Optional[MappingInput[str]] = None,
         code: Optional[Input[str]] = None,
         s3_bucket: Optional[Input[str]] = None,
@@ -46,6 +47,8 @@ class FunctionArgs:
             set_field(self, "timeout", timeout)
         if memory_size is not None:
             set_field(self, "memory_size", memory_size)
+        if architecture is not None:
+            set_field(self, "architecture", architecture)
         if environment_variables is not None:
             set_field(self, "environment_variables", environment_variables)
         if code is not None:
@@ -97,6 +100,14 @@ class FunctionArgs:
     def memory_size(self, value: Optional[Input[int]]) -> None:
         set_field(self, "memory_size", value)
 
+    @property
+    def architecture(self) -> Optional[Input[str]]:
+        return get_field(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[Input[str]]) -> None:
+        set_field(self, "architecture", value)
+
     @property
     def environment_variables(self) -> Optional[MappingInput[str]]:
         return get_field(self, "environment_variables")
@@ -177,6 +188,7 @@ class Function(Construct):
         runtime: Optional[Input[str]] = None,
         timeout: Optional[Input[int]] = None,
         memory_size: Optional[Input[int]] = None,
+        architecture: Optional[Input[str]] = None,
         environment_variables: Optional[MappingInput[str]] = None,
         code: Optional[Input[str]] = None,
         s3_bucket: Optional[Input[str]] = None,
@@ -203,6 +215,7 @@ class Function(Construct):
         runtime: Optional[Input[str]] = None,
         timeout: Optional[Input[int]] = None,
         memory_size: Optional[Input[int]] = None,
+        architecture: Optional[Input[str]] = None,
         environment_variables: Optional[MappingInput[str]] = None,
         code: Optional[Input[str]] = None,
         s3_bucket: Optional[Input[str]] = None,
@@ -222,6 +235,7 @@ class Function(Construct):
                 "Runtime": runtime,
                 "Timeout": timeout,
                 "MemorySize": memory_size,
+                "Architecture": architecture,
                 "EnvironmentVariables": (
                     Output.from_mapping(environment_variables)
                     if environment_variables
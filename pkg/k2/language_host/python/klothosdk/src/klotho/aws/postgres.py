@@ -3,9 +3,12 @@ from typing import Optional, overload
 from klotho.aws.network import Network
 from klotho.construct import ConstructOptions, get_construct_args_opts, Construct
 from klotho.output import Input
+from klotho.runtime import instance as runtime
 from klotho.runtime_util import get_default_construct
 from klotho.type_util import set_field, get_field
 
+PRODUCTION_ENVIRONMENTS = ("prod", "production")
+
 
 class PostgresArgs:
     """Arguments for configuring a Postgres database."""
@@ -19,7 +22,9 @@ class PostgresArgs:
                  username: Optional[Input[str]] = None,
                  password: Optional[Input[str]] = None,
                  port: Optional[Input[int]] = None,
-                 network: Optional[Network] = None
+                 network: Optional[Network] = None,
+                 multi_az: Optional[Input[bool]] = None,
+                 read_replica: Optional[Input[bool]] = None
                 ):
         if instance_class is not None:
             set_field(self, "instance_class", instance_class)
@@ -37,6 +42,10 @@ class PostgresArgs:
             set_field(self, "port", port)
         if network is not None:
             set_field(self, "network", network)
+        if multi_az is not None:
+            set_field(self, "multi_az", multi_az)
+        if read_replica is not None:
+            set_field(self, "read_replica", read_replica)
 
     def _get_property(self, name: str):
         return get_field(self, name)
@@ -108,6 +117,22 @@ class PostgresArgs:
     def network(self, value: Optional[Network]) -> None:
         self._set_property("network", value)
 
+    @property
+    def multi_az(self) -> Optional[Input[bool]]:
+        return self._get_property("multi_az")
+
+    @multi_az.setter
+    def multi_az(self, value: Optional[Input[bool]]) -> None:
+        self._set_property("multi_az", value)
+
+    @property
+    def read_replica(self) -> Optional[Input[bool]]:
+        return self._get_property("read_replica")
+
+    @read_replica.setter
+    def read_replica(self, value: Optional[Input[bool]]) -> None:
+        self._set_property("read_replica", value)
+
 
 class Postgres(Construct):
     """Represents a Postgres database construct in AWS."""
@@ -129,6 +154,8 @@ class Postgres(Construct):
         database_name: Optional[Input[str]] = None,
         port: Optional[Input[int]] = None,
         network: Optional[Network] = None,
+        multi_az: Optional[Input[bool]] = None,
+        read_replica: Optional[Input[bool]] = None,
         opts: Optional[ConstructOptions] = None,
     ): ...
 
@@ -151,6 +178,8 @@ class Postgres(Construct):
         database_name: Optional[Input[str]] = None,
         port: Optional[Input[int]] = None,
         network: Optional[Network] = None,
+        multi_az: Optional[Input[bool]] = None,
+        read_replica: Optional[Input[bool]] = None,
     ):
         """Internal initializer for Postgres."""
         if network is None:
@@ -160,6 +189,11 @@ class Postgres(Construct):
             instance_class = "db.t3.micro"
         if allocated_storage is None:
             allocated_storage = 20
+        if multi_az is None:
+            # production databases get a standby in a second availability zone unless told otherwise
+            multi_az = runtime.application is not None and runtime.application.environment in PRODUCTION_ENVIRONMENTS
+        if read_replica is None:
+            read_replica = False
 
         super().__init__(
             name,
@@ -173,6 +207,8 @@ class Postgres(Construct):
                 "DatabaseName": database_name,
                 "Port": port,
                 "Network": network,
+                "MultiAz": multi_az,
+                "ReadReplica": read_replica,
             },
             opts=opts,
         )
@@ -9,9 +9,31 @@ BindingType = Union["Binding", "Construct"]
 
 class ConstructOptions:
     """
-    This class is a placeholder for when we need to add common options to constructs.
+    Options common to all constructs.
+
+    :param name_prefix: Prepended to the names of the resources the construct creates, so
+        that they are grouped together (e.g. ``my-app-api`` names resources ``my-app-api-*``).
+    :param region: The AWS region to deploy the construct to, overriding the application's
+        ``default_region``.
+    :param tags: Tags added to every resource the construct creates, unless the resource
+        sets the tag itself.
+    :param ignore_dependencies: Dependencies between the construct's resources, written as
+        ``"source -> target"`` resource IDs, to leave out of the deployment ordering to break
+        a false cycle.
     """
 
+    def __init__(
+        self,
+        name_prefix: Optional[str] = None,
+        region: Optional[str] = None,
+        tags: Optional[dict[str, str]] = None,
+        ignore_dependencies: Optional[list[str]] = None,
+    ):
+        self.name_prefix = name_prefix
+        self.region = region
+        self.tags = tags
+        self.ignore_dependencies = ignore_dependencies
+
 
 class Construct:
     def __init__(
@@ -45,7 +67,9 @@ class Construct:
         self.version = 1
         self.status = "new"  # Default status
         self.bindings: list["Binding"] = []
-        self.options = opts.__dict__ if opts else {}
+        self.options = (
+            {k: v for k, v in opts.__dict__.items() if v is not None} if opts else {}
+        )
         self.depends_on: set[URN] = set()
         runtime.add_construct(self)
         for k, v in properties.items():
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"fmt"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
 type ConstructState struct {
 	Status      ConstructStatus  `yaml:"status,omitempty"`
 	LastUpdated string           `yaml:"last_updated,omitempty"`
@@ -100,3 +106,47 @@ const (
 	ConstructActionUpdate ConstructAction = "update"
 	ConstructActionDelete ConstructAction = "delete"
 )
+
+// Region returns the AWS region the construct is deployed to, which is its "region" option if set, otherwise the
+// application's default region.
+func (c ConstructState) Region(defaultRegion string) string {
+	if region, ok := c.Options["region"].(string); ok && region != "" {
+		return region
+	}
+	return defaultRegion
+}
+
+// Tags returns the construct's "tags" option, which are added to every resource derived from the construct unless the
+// resource sets the tag itself.
+func (c ConstructState) Tags() map[string]string {
+	raw, ok := c.Options["tags"].(map[string]any)
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	tags := make(map[string]string, len(raw))
+	for k, v := range raw {
+		tags[k] = fmt.Sprint(v)
+	}
+	return tags
+}
+
+// IgnoredDependencies returns the construct's "ignore_dependencies" option, the deployment dependencies
+// ('source -> target') between its resources which are left out of the cycle check and resource ordering to break a
+// false cycle.
+func (c ConstructState) IgnoredDependencies() ([]construct.SimpleEdge, error) {
+	raw, ok := c.Options["ignore_dependencies"].([]any)
+	if !ok {
+		return nil, nil
+	}
+	deps := make([]construct.SimpleEdge, len(raw))
+	for i, v := range raw {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid ignored dependency %v: expected a string", v)
+		}
+		if err := deps[i].UnmarshalText([]byte(s)); err != nil {
+			return nil, fmt.Errorf("invalid ignored dependency %q: %w", s, err)
+		}
+	}
+	return deps, nil
+}
@@ -1,7 +1,10 @@
 package model
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
 )
 
 func TestIsDeployable(t *testing.T) {
@@ -66,3 +69,76 @@ func TestIsValidTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructStateRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  map[string]any
+		expected string
+	}{
+		{"no options", nil, "us-east-1"},
+		{"region override", map[string]any{"region": "us-west-2"}, "us-west-2"},
+		{"empty region", map[string]any{"region": ""}, "us-east-1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := ConstructState{Options: test.options}
+			if result := c.Region("us-east-1"); result != test.expected {
+				t.Errorf("Region() = %v; want %v", result, test.expected)
+			}
+		})
+	}
+}
+
+func TestConstructStateTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  map[string]any
+		expected map[string]string
+	}{
+		{"no options", nil, nil},
+		{"tags", map[string]any{"tags": map[string]any{"team": "data", "cost-center": 42}}, map[string]string{"team": "data", "cost-center": "42"}},
+		{"empty tags", map[string]any{"tags": map[string]any{}}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := ConstructState{Options: test.options}
+			if result := c.Tags(); !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Tags() = %v; want %v", result, test.expected)
+			}
+		})
+	}
+}
+
+func TestConstructStateIgnoredDependencies(t *testing.T) {
+	edge := construct.SimpleEdge{
+		Source: construct.ResourceId{Provider: "aws", Type: "rest_api", Name: "api"},
+		Target: construct.ResourceId{Provider: "aws", Type: "api_deployment", Name: "deployment"},
+	}
+	tests := []struct {
+		name     string
+		options  map[string]any
+		expected []construct.SimpleEdge
+		wantErr  bool
+	}{
+		{"no options", nil, nil, false},
+		{"dependencies", map[string]any{"ignore_dependencies": []any{"aws:rest_api:api -> aws:api_deployment:deployment"}}, []construct.SimpleEdge{edge}, false},
+		{"invalid dependency", map[string]any{"ignore_dependencies": []any{"aws:rest_api:api"}}, nil, true},
+		{"not a string", map[string]any{"ignore_dependencies": []any{42}}, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := ConstructState{Options: test.options}
+			result, err := c.IgnoredDependencies()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("IgnoredDependencies() error = %v; wantErr %v", err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("IgnoredDependencies() = %v; want %v", result, test.expected)
+			}
+		})
+	}
+}
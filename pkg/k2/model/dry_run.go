@@ -14,4 +14,8 @@ const (
 
 	// DryRunFileOnly is a dry run that only writes the files to disk
 	DryRunFileOnly
+
+	// DryRunPlan is a dry run that compares the compiled resources against the deployed stack state, without
+	// calling the cloud provider
+	DryRunPlan
 )
@@ -46,6 +46,10 @@ func (o *Orchestrator) InfraGenerator() (*InfraGenerator, error) {
 }
 
 func (uo *UpOrchestrator) EvaluateConstruct(ctx context.Context, state model.State, constructUrn model.URN) (stack.Reference, error) {
+	cState, ok := state.Constructs[constructUrn.ResourceID]
+	if !ok {
+		return stack.Reference{}, fmt.Errorf("could not get state for construct: %s", constructUrn)
+	}
 	constructOutDir := filepath.Join(uo.OutputDirectory, constructUrn.ResourceID)
 
 	err := uo.FS.MkdirAll(constructOutDir, 0755)
@@ -58,6 +62,11 @@ func (uo *UpOrchestrator) EvaluateConstruct(ctx context.Context, state model.Sta
 		return stack.Reference{}, err
 	}
 	req.GlobalTag = "k2" // TODO make this meaningful?
+	req.Tags = cState.Tags()
+	req.IgnoredDependencies, err = cState.IgnoredDependencies()
+	if err != nil {
+		return stack.Reference{}, err
+	}
 
 	ig, err := uo.InfraGenerator()
 	if err != nil {
@@ -74,7 +83,8 @@ func (uo *UpOrchestrator) EvaluateConstruct(ctx context.Context, state model.Sta
 		ConstructURN: constructUrn,
 		Name:         constructUrn.ResourceID,
 		IacDirectory: constructOutDir,
-		AwsRegion:    uo.StateManager.GetState().DefaultRegion,
+		AwsRegion:    cState.Region(state.DefaultRegion),
+		Retry:        stack.DefaultRetryPolicy,
 	}, nil
 }
 
@@ -85,18 +95,23 @@ func (o *Orchestrator) resolveInitialState(ir *model.ApplicationEnvironment) (ma
 	//TODO: implement some kind of versioning check
 	state.Version += 1
 
-	// Check for default region mismatch
-	if state.DefaultRegion != ir.DefaultRegion {
-		deployed := make(map[string]model.ConstructStatus)
-		for k, v := range state.Constructs {
-			if model.IsDeletable(v.Status) {
-				deployed[k] = v.Status
-			}
+	// Check for region changes to deployed constructs, either from the default region or the construct's override
+	changed := make(map[string]string)
+	for k, v := range state.Constructs {
+		if !model.IsDeletable(v.Status) {
+			continue
+		}
+		next := v
+		if c, ok := ir.Constructs[k]; ok {
+			next.Options = c.Options
 		}
-		if len(deployed) > 0 {
-			return nil, fmt.Errorf("cannot change region (%s -> %s) with deployed resources: %v", state.DefaultRegion, ir.DefaultRegion, deployed)
+		if from, to := v.Region(state.DefaultRegion), next.Region(ir.DefaultRegion); from != to {
+			changed[k] = fmt.Sprintf("%s -> %s", from, to)
 		}
 	}
+	if len(changed) > 0 {
+		return nil, fmt.Errorf("cannot change region with deployed resources: %v", changed)
+	}
 
 	// Check for schema version mismatch
 	if state.SchemaVersion != ir.SchemaVersion {
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/klothoplatform/klotho/pkg/construct"
 	"github.com/klothoplatform/klotho/pkg/engine/debug"
 	"github.com/klothoplatform/klotho/pkg/k2/constructs"
 	pb "github.com/klothoplatform/klotho/pkg/k2/language_host/go"
@@ -139,6 +141,20 @@ func (uo *UpOrchestrator) placeholderOutputs(ctx context.Context, cURN model.URN
 	}
 }
 
+// logPlan logs the plan of the changes to the stack to deploy the next graph.
+func (uo *UpOrchestrator) logPlan(ctx context.Context, stackRef stack.Reference, next construct.Graph) error {
+	plan, err := stack.RunPlan(ctx, uo.FS, stackRef, next)
+	if err != nil {
+		return fmt.Errorf("error planning changes: %w", err)
+	}
+	buf := new(strings.Builder)
+	if err := plan.Print(buf); err != nil {
+		return err
+	}
+	logging.GetLogger(ctx).Sugar().Infof("Plan for %s:\n%s", stackRef.ConstructURN.ResourceID, buf)
+	return nil
+}
+
 func (uo *UpOrchestrator) executeAction(ctx context.Context, c model.ConstructState, action model.ConstructAction, dryRun model.DryRun) (err error) {
 	sm := uo.StateManager
 	log := logging.GetLogger(ctx).Sugar()
@@ -178,6 +194,18 @@ func (uo *UpOrchestrator) executeAction(ctx context.Context, c model.ConstructSt
 			return nil
 		}
 
+		stackRef := stack.Reference{
+			ConstructURN: *c.URN,
+			Name:         c.URN.ResourceID,
+			IacDirectory: outDir,
+			AwsRegion:    c.Region(sm.GetState().DefaultRegion),
+			Retry:        stack.DefaultRetryPolicy,
+		}
+
+		if dryRun == model.DryRunPlan {
+			// Planning against an empty graph lists all of the construct's deployed resources as removed
+			return uo.logPlan(ctx, stackRef, construct.NewGraph())
+		}
 		if dryRun > 0 {
 			log.Infof("Dry run: Skipping pulumi down for deleted construct %s", c.URN.ResourceID)
 			return nil
@@ -188,12 +216,7 @@ func (uo *UpOrchestrator) executeAction(ctx context.Context, c model.ConstructSt
 			return err
 		}
 
-		err = stack.RunDown(ctx, uo.FS, stack.Reference{
-			ConstructURN: *c.URN,
-			Name:         c.URN.ResourceID,
-			IacDirectory: outDir,
-			AwsRegion:    sm.GetState().DefaultRegion,
-		})
+		err = stack.RunDown(ctx, uo.FS, stackRef)
 
 		if err != nil {
 			if err2 := sm.TransitionConstructFailed(&c); err2 != nil {
@@ -254,6 +277,18 @@ func (uo *UpOrchestrator) executeAction(ctx context.Context, c model.ConstructSt
 		// file already written, nothing left to do
 		uo.placeholderOutputs(ctx, *c.URN)
 		return sm.RegisterOutputValues(ctx, stackRef.ConstructURN, map[string]any{})
+
+	case model.DryRunPlan:
+		constructState, ok := uo.ConstructEvaluator.Constructs.Get(*c.URN)
+		if !ok {
+			return fmt.Errorf("construct %s was not evaluated", c.URN)
+		}
+		err = uo.logPlan(ctx, stackRef, constructState.Solution.DeploymentGraph())
+		uo.placeholderOutputs(ctx, *c.URN)
+		if err != nil {
+			return err
+		}
+		return sm.RegisterOutputValues(ctx, stackRef.ConstructURN, map[string]any{})
 	}
 
 	// Run pulumi up command for the construct
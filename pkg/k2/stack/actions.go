@@ -27,6 +27,8 @@ type Reference struct {
 	Name         string
 	IacDirectory string
 	AwsRegion    string
+	// Retry is how the stack's Pulumi operations are retried after a transient failure
+	Retry RetryPolicy
 }
 
 func Initialize(ctx context.Context, fs afero.Fs, projectName string, stackName string, stackDirectory string) (StackInterface, error) {
@@ -100,12 +102,14 @@ func RunUp(ctx context.Context, fs afero.Fs, stackReference Reference) (*auto.Up
 
 	log.Debug("Starting update")
 
-	upResult, err := s.Up(
-		ctx,
-		optup.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel)),
-		optup.EventStreams(Events(ctx, "Deploying")),
-		optup.Refresh(),
-	)
+	upResult, err := withRetries(ctx, stackReference.Retry, log, func() (auto.UpResult, error) {
+		return s.Up(
+			ctx,
+			optup.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel)),
+			optup.EventStreams(Events(ctx, "Deploying")),
+			optup.Refresh(),
+		)
+	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed to update stack: %w", err)
 	}
@@ -141,12 +145,14 @@ func RunPreview(ctx context.Context, fs afero.Fs, stackReference Reference) (*au
 
 	log.Debug("Starting preview")
 
-	previewResult, err := s.Preview(
-		ctx,
-		optpreview.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel)),
-		optpreview.EventStreams(Events(ctx, "Previewing")),
-		optpreview.Refresh(),
-	)
+	previewResult, err := withRetries(ctx, stackReference.Retry, log, func() (auto.PreviewResult, error) {
+		return s.Preview(
+			ctx,
+			optpreview.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel)),
+			optpreview.EventStreams(Events(ctx, "Previewing")),
+			optpreview.Refresh(),
+		)
+	})
 
 	if err != nil {
 		str := err.Error()
@@ -188,13 +194,8 @@ func RunDown(ctx context.Context, fs afero.Fs, stackReference Reference) error {
 
 	log.Debug("Starting destroy")
 
-	// wire up our destroy to stream progress to stdout
-	stdoutStreamer := optdestroy.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel))
-	refresh := optdestroy.Refresh()
-	eventStream := optdestroy.EventStreams(Events(ctx, "Destroying"))
-
 	// run the destroy to remove our resources
-	_, err = s.Destroy(ctx, stdoutStreamer, eventStream, refresh)
+	err = destroyWithRetries(ctx, s, stackReference.Retry, log)
 	if err != nil {
 		return fmt.Errorf("Failed to destroy stack: %w", err)
 	}
@@ -209,6 +210,24 @@ func RunDown(ctx context.Context, fs afero.Fs, stackReference Reference) error {
 	return nil
 }
 
+// destroyer is the part of [auto.Stack] used to destroy it.
+type destroyer interface {
+	Destroy(ctx context.Context, opts ...optdestroy.Option) (auto.DestroyResult, error)
+}
+
+func destroyWithRetries(ctx context.Context, s destroyer, retry RetryPolicy, log *zap.SugaredLogger) error {
+	_, err := withRetries(ctx, retry, log, func() (auto.DestroyResult, error) {
+		// Each attempt needs its own event stream, since Pulumi closes the stream's channel once the destroy is done
+		return s.Destroy(
+			ctx,
+			optdestroy.ProgressStreams(logging.NewLoggerWriter(log.Desugar(), zap.InfoLevel)),
+			optdestroy.EventStreams(Events(ctx, "Destroying")),
+			optdestroy.Refresh(),
+		)
+	})
+	return err
+}
+
 func InstallDependencies(ctx context.Context, stackDirectory string) error {
 	prog := tui.GetProgress(ctx)
 	log := logging.GetLogger(ctx).Named("npm").Sugar()
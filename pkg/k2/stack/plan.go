@@ -0,0 +1,183 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/spf13/afero"
+)
+
+// Plan is the difference between the resources in a stack's deployed state and a newly compiled graph. Unlike a
+// Pulumi preview, it only compares the graphs and does not make any calls to the cloud provider.
+type Plan struct {
+	Added   []construct.ResourceId
+	Removed []construct.ResourceId
+	// Changed are the resources in both graphs whose dependencies were added or removed
+	Changed []construct.ResourceId
+
+	AddedEdges   []construct.SimpleEdge
+	RemovedEdges []construct.SimpleEdge
+}
+
+// RunPlan compares the stack's state, as of its last deployment, against the newly compiled graph.
+func RunPlan(ctx context.Context, fs afero.Fs, stackReference Reference, next construct.Graph) (*Plan, error) {
+	log := logging.GetLogger(ctx).Named("pulumi.plan").Sugar()
+
+	s, err := Initialize(ctx, fs, "myproject", stackReference.Name, stackReference.IacDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create or select stack: %w", err)
+	}
+	log.Debugf("Created/Selected stack %q", stackReference.Name)
+
+	var previous State
+	outputs, err := s.Outputs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get stack outputs: %w", err)
+	}
+	// A stack which has never been deployed has no outputs, so everything in the graph will be added
+	if len(outputs) > 0 {
+		previous, err = GetState(ctx, s)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get stack state: %w", err)
+		}
+	}
+
+	plan, err := PlanChanges(previous, next)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to plan changes: %w", err)
+	}
+	return &plan, nil
+}
+
+// PlanChanges compares the resources and dependencies in the previous state against the next graph.
+func PlanChanges(previous State, next construct.Graph) (Plan, error) {
+	var plan Plan
+
+	idByUrn := make(map[resource.URN]construct.ResourceId, len(previous.Resources))
+	for id, res := range previous.Resources {
+		idByUrn[res.URN] = id
+	}
+	previousEdges := make(map[construct.SimpleEdge]struct{})
+	for id, res := range previous.Resources {
+		for _, dep := range res.Dependencies {
+			// Dependencies on resources which aren't in the graph (such as providers) are skipped
+			if depId, ok := idByUrn[dep]; ok {
+				previousEdges[construct.SimpleEdge{Source: id, Target: depId}] = struct{}{}
+			}
+		}
+	}
+
+	adj, err := next.AdjacencyMap()
+	if err != nil {
+		return Plan{}, err
+	}
+	nextEdges := make(map[construct.SimpleEdge]struct{})
+	for id, deps := range adj {
+		if _, ok := previous.Resources[id]; !ok {
+			plan.Added = append(plan.Added, id)
+		}
+		for dep := range deps {
+			nextEdges[construct.SimpleEdge{Source: id, Target: dep}] = struct{}{}
+		}
+	}
+	for id := range previous.Resources {
+		if _, ok := adj[id]; !ok {
+			plan.Removed = append(plan.Removed, id)
+		}
+	}
+
+	changed := make(map[construct.ResourceId]struct{})
+	markChanged := func(e construct.SimpleEdge) {
+		_, inPrevious := previous.Resources[e.Source]
+		_, inNext := adj[e.Source]
+		if inPrevious && inNext {
+			changed[e.Source] = struct{}{}
+		}
+	}
+	for e := range nextEdges {
+		if _, ok := previousEdges[e]; !ok {
+			plan.AddedEdges = append(plan.AddedEdges, e)
+			markChanged(e)
+		}
+	}
+	for e := range previousEdges {
+		if _, ok := nextEdges[e]; !ok {
+			plan.RemovedEdges = append(plan.RemovedEdges, e)
+			markChanged(e)
+		}
+	}
+	for id := range changed {
+		plan.Changed = append(plan.Changed, id)
+	}
+
+	sort.Sort(construct.SortedIds(plan.Added))
+	sort.Sort(construct.SortedIds(plan.Removed))
+	sort.Sort(construct.SortedIds(plan.Changed))
+	sortEdges := func(edges []construct.SimpleEdge) {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Less(edges[j]) })
+	}
+	sortEdges(plan.AddedEdges)
+	sortEdges(plan.RemovedEdges)
+	return plan, nil
+}
+
+// IsEmpty returns whether the plan has no changes.
+func (p Plan) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0 && len(p.Changed) == 0
+}
+
+// Print writes the plan grouped by resource type, each resource prefixed with '+' (added), '-' (removed) or
+// '~' (changed), followed by the dependency edges which were added or removed.
+func (p Plan) Print(w io.Writer) error {
+	if p.IsEmpty() {
+		_, err := fmt.Fprintln(w, "No changes")
+		return err
+	}
+
+	type change struct {
+		id     construct.ResourceId
+		symbol string
+	}
+	byType := make(map[string][]change)
+	for _, c := range []struct {
+		ids    []construct.ResourceId
+		symbol string
+	}{{p.Added, "+"}, {p.Removed, "-"}, {p.Changed, "~"}} {
+		for _, id := range c.ids {
+			t := id.QualifiedTypeName()
+			byType[t] = append(byType[t], change{id: id, symbol: c.symbol})
+		}
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	buf := new(strings.Builder)
+	for _, t := range types {
+		changes := byType[t]
+		sort.Slice(changes, func(i, j int) bool { return construct.ResourceIdLess(changes[i].id, changes[j].id) })
+		fmt.Fprintf(buf, "%s\n", t)
+		for _, c := range changes {
+			fmt.Fprintf(buf, "  %s %s\n", c.symbol, c.id)
+		}
+	}
+	if len(p.AddedEdges) > 0 || len(p.RemovedEdges) > 0 {
+		buf.WriteString("dependencies\n")
+		for _, e := range p.AddedEdges {
+			fmt.Fprintf(buf, "  + %s\n", e)
+		}
+		for _, e := range p.RemovedEdges {
+			fmt.Fprintf(buf, "  - %s\n", e)
+		}
+	}
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
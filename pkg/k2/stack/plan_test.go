@@ -0,0 +1,147 @@
+package stack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanChanges(t *testing.T) {
+	// deployedState builds a State where each resource depends on the resources listed for it
+	deployedState := func(deps map[string][]string) State {
+		state := State{Resources: make(map[construct.ResourceId]apitype.ResourceV3)}
+		urn := func(id string) resource.URN { return resource.URN("urn:pulumi:stack::project::" + id) }
+		for id, ds := range deps {
+			res := apitype.ResourceV3{URN: urn(id)}
+			for _, d := range ds {
+				res.Dependencies = append(res.Dependencies, urn(d))
+			}
+			state.Resources[graphtest.ParseId(t, id)] = res
+		}
+		return state
+	}
+	ids := func(strs ...string) []construct.ResourceId {
+		var result []construct.ResourceId
+		for _, s := range strs {
+			result = append(result, graphtest.ParseId(t, s))
+		}
+		return result
+	}
+	edges := func(strs ...string) []construct.SimpleEdge {
+		var result []construct.SimpleEdge
+		for _, s := range strs {
+			result = append(result, construct.ToSimpleEdge(graphtest.ParseEdge(t, s)))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		previous State
+		next     []any
+		want     Plan
+	}{
+		{
+			name:     "never deployed",
+			previous: State{},
+			next:     []any{"aws:lambda_function:fn -> aws:iam_role:fn-role"},
+			want: Plan{
+				Added:      ids("aws:iam_role:fn-role", "aws:lambda_function:fn"),
+				AddedEdges: edges("aws:lambda_function:fn -> aws:iam_role:fn-role"),
+			},
+		},
+		{
+			name: "no changes",
+			previous: deployedState(map[string][]string{
+				"aws:lambda_function:fn": {"aws:iam_role:fn-role"},
+				"aws:iam_role:fn-role":   nil,
+			}),
+			next: []any{"aws:lambda_function:fn -> aws:iam_role:fn-role"},
+			want: Plan{},
+		},
+		{
+			name: "resource added and removed",
+			previous: deployedState(map[string][]string{
+				"aws:lambda_function:fn": {"aws:iam_role:fn-role"},
+				"aws:iam_role:fn-role":   nil,
+				"aws:sqs_queue:queue":    nil,
+			}),
+			next: []any{
+				"aws:lambda_function:fn -> aws:iam_role:fn-role",
+				"aws:lambda_function:fn -> aws:s3_bucket:bucket",
+			},
+			want: Plan{
+				Added:      ids("aws:s3_bucket:bucket"),
+				Removed:    ids("aws:sqs_queue:queue"),
+				Changed:    ids("aws:lambda_function:fn"),
+				AddedEdges: edges("aws:lambda_function:fn -> aws:s3_bucket:bucket"),
+			},
+		},
+		{
+			name: "dependency removed",
+			previous: deployedState(map[string][]string{
+				"aws:lambda_function:fn": {"aws:iam_role:fn-role", "pulumi:providers:aws::default"},
+				"aws:iam_role:fn-role":   nil,
+			}),
+			next: []any{"aws:lambda_function:fn", "aws:iam_role:fn-role"},
+			want: Plan{
+				Changed:      ids("aws:lambda_function:fn"),
+				RemovedEdges: edges("aws:lambda_function:fn -> aws:iam_role:fn-role"),
+			},
+		},
+		{
+			name: "construct deleted",
+			previous: deployedState(map[string][]string{
+				"aws:lambda_function:fn": {"aws:iam_role:fn-role"},
+				"aws:iam_role:fn-role":   nil,
+			}),
+			next: nil,
+			want: Plan{
+				Removed:      ids("aws:iam_role:fn-role", "aws:lambda_function:fn"),
+				RemovedEdges: edges("aws:lambda_function:fn -> aws:iam_role:fn-role"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := graphtest.MakeGraph(t, construct.NewGraph(), tt.next...)
+
+			got, err := PlanChanges(tt.previous, next)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlan_Print(t *testing.T) {
+	plan := Plan{
+		Added:   []construct.ResourceId{{Provider: "aws", Type: "s3_bucket", Name: "bucket"}},
+		Removed: []construct.ResourceId{{Provider: "aws", Type: "sqs_queue", Name: "queue"}},
+		Changed: []construct.ResourceId{{Provider: "aws", Type: "lambda_function", Name: "fn"}},
+		AddedEdges: []construct.SimpleEdge{{
+			Source: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+			Target: construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+		}},
+	}
+	buf := new(strings.Builder)
+	require.NoError(t, plan.Print(buf))
+	assert.Equal(t, `aws:lambda_function
+  ~ aws:lambda_function:fn
+aws:s3_bucket
+  + aws:s3_bucket:bucket
+aws:sqs_queue
+  - aws:sqs_queue:queue
+dependencies
+  + aws:lambda_function:fn -> aws:s3_bucket:bucket
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Plan{}.Print(buf))
+	assert.Equal(t, "No changes\n", buf.String())
+}
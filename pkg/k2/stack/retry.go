@@ -0,0 +1,86 @@
+package stack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+	"go.uber.org/zap"
+)
+
+// RetryPolicy configures how stack operations are retried when they fail with a transient error.
+type RetryPolicy struct {
+	// MaxRetries is the number of times to retry after the first attempt. Zero disables retries.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, which doubles for each subsequent retry.
+	BaseDelay time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  10 * time.Second,
+}
+
+// retryablePatterns are the (lowercase) messages of errors which are expected to succeed if the operation is run
+// again, such as AWS API throttling or IAM changes which have not propagated yet.
+var retryablePatterns = []string{
+	// throttling
+	"throttling",
+	"throttled",
+	"rate exceeded",
+	"requestlimitexceeded",
+	"toomanyrequestsexception",
+	"slowdown",
+	// IAM eventual consistency
+	"cannot be assumed by",
+	"the provided execution role does not have permissions",
+	"role is not authorized to perform",
+}
+
+// IsRetryableError returns whether err is a transient failure that is worth retrying. Errors from the IaC program
+// itself and stack conflicts are never retryable.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if auto.IsCompilationError(err) ||
+		auto.IsRuntimeError(err) ||
+		auto.IsConcurrentUpdateError(err) ||
+		auto.IsCreateStack409Error(err) {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, pattern := range retryablePatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// withRetries runs op, retrying it according to the policy while it fails with a retryable error.
+func withRetries[T any](
+	ctx context.Context,
+	policy RetryPolicy,
+	log *zap.SugaredLogger,
+	op func() (T, error),
+) (T, error) {
+	delay := policy.BaseDelay
+	for attempt := 1; ; attempt++ {
+		result, err := op()
+		if err == nil || attempt > policy.MaxRetries || !IsRetryableError(err) {
+			return result, err
+		}
+		// Use the first line only, the rest is already shown in the live logging
+		firstLine := strings.Split(err.Error(), "\n")[0]
+		log.Warnf("Retrying after transient error (retry %d of %d in %s): %s", attempt, policy.MaxRetries, delay, firstLine)
+
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
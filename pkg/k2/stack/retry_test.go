@@ -0,0 +1,140 @@
+package stack
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optdestroy"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "throttling",
+			err:  errors.New("error: creating Lambda Function: ThrottlingException: Rate exceeded"),
+			want: true,
+		},
+		{
+			name: "request limit",
+			err:  errors.New("RequestLimitExceeded: Request limit exceeded."),
+			want: true,
+		},
+		{
+			name: "iam role not propagated",
+			err:  errors.New("InvalidParameterValueException: The role defined for the function cannot be assumed by Lambda."),
+			want: true,
+		},
+		{
+			name: "resource conflict",
+			err:  errors.New("BucketAlreadyExists: The requested bucket name is not available"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryableError(tt.err))
+		})
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	throttled := errors.New("ThrottlingException: Rate exceeded")
+	conflict := errors.New("BucketAlreadyExists: The requested bucket name is not available")
+
+	tests := []struct {
+		name         string
+		policy       RetryPolicy
+		errs         []error
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "succeeds after retries",
+			policy:       RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond},
+			errs:         []error{throttled, throttled, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "non-retryable fails fast",
+			policy:       RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond},
+			errs:         []error{conflict, nil},
+			wantAttempts: 1,
+			wantErr:      conflict,
+		},
+		{
+			name:         "retries exhausted",
+			policy:       RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond},
+			errs:         []error{throttled, throttled, throttled, nil},
+			wantAttempts: 3,
+			wantErr:      throttled,
+		},
+		{
+			name:         "retries disabled",
+			policy:       RetryPolicy{},
+			errs:         []error{throttled, nil},
+			wantAttempts: 1,
+			wantErr:      throttled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			result, err := withRetries(context.Background(), tt.policy, zap.NewNop().Sugar(), func() (int, error) {
+				err := tt.errs[attempts]
+				attempts++
+				return attempts, err
+			})
+			assert.Equal(t, tt.wantAttempts, attempts)
+			assert.Equal(t, tt.wantAttempts, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// closingDestroyer fails with each of errs in turn, sending an event to and then closing the event streams it is given
+// on every attempt, as Pulumi does.
+type closingDestroyer struct {
+	errs     []error
+	attempts int
+}
+
+func (d *closingDestroyer) Destroy(ctx context.Context, opts ...optdestroy.Option) (auto.DestroyResult, error) {
+	var options optdestroy.Options
+	for _, opt := range opts {
+		opt.ApplyOption(&options)
+	}
+	for _, ch := range options.EventStreams {
+		ch <- events.EngineEvent{}
+		close(ch)
+	}
+	err := d.errs[d.attempts]
+	d.attempts++
+	return auto.DestroyResult{}, err
+}
+
+func TestDestroyWithRetries(t *testing.T) {
+	d := &closingDestroyer{errs: []error{errors.New("ThrottlingException: Rate exceeded"), nil}}
+	err := destroyWithRetries(
+		context.Background(),
+		d,
+		RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond},
+		zap.NewNop().Sugar(),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, d.attempts)
+}
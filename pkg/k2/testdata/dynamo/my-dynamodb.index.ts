@@ -10,6 +10,7 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:dynamodb_table:my-dynamodb
 const my_dynamodb = new aws.dynamodb.Table(
         "my-dynamodb",
         {
@@ -36,6 +37,7 @@ const my_dynamodb = new aws.dynamodb.Table(
             hashKey: "id",
             rangeKey: "data",
             billingMode: "PAY_PER_REQUEST",
+            tableClass: "STANDARD",
             tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-dynamodb"},
             globalSecondaryIndexes: [{hashKey: "status", name: "StatusIndex", projectionType: "ALL"}],
             localSecondaryIndexes: [{name: "TimestampIndex", projectionType: "ALL", rangeKey: "timestamp"}],
@@ -12,6 +12,7 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:ecr_repo:docker-func-image-ecr_repo
 const docker_func_image_ecr_repo = new aws.ecr.Repository("docker-func-image-ecr_repo", {
         imageScanningConfiguration: {
             scanOnPush: true,
@@ -21,6 +22,7 @@ const docker_func_image_ecr_repo = new aws.ecr.Repository("docker-func-image-ecr
         encryptionConfigurations: [{ encryptionType: 'KMS' }],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "docker-func-image-ecr_repo"},
     })
+// aws:iam_role:docker-func-function-ExecutionRole
 const docker_func_function_executionrole = new aws.iam.Role("docker-func-function-ExecutionRole", {
         assumeRolePolicy: pulumi.jsonStringify({Statement: [{Action: ["sts:AssumeRole"], Effect: "Allow", Principal: {Service: ["lambda.amazonaws.com"]}}], Version: "2012-10-17"}),
         managedPolicyArns: [
@@ -28,6 +30,7 @@ const docker_func_function_executionrole = new aws.iam.Role("docker-func-functio
         ],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "docker-func-function-ExecutionRole"},
     })
+// aws:ecr_image:docker-func-image
 const docker_func_image = (() => {
         const base = new docker.Image(`${"docker-func-image"}-base`, {
             build: {
@@ -71,6 +74,7 @@ const docker_func_image = (() => {
             { parent: base }
         )
     })()
+// aws:lambda_function:docker-func-function
 const docker_func_function = new aws.lambda.Function(
         "docker-func-function",
         {
@@ -88,6 +92,7 @@ const docker_func_function = new aws.lambda.Function(
             dependsOn: [docker_func_function_executionrole, docker_func_image],
         }
     )
+// aws:log_group:docker-func-function-log_group
 const docker_func_function_log_group = new aws.cloudwatch.LogGroup("docker-func-function-log_group", {
         name: pulumi.interpolate`/aws/lambda/${docker_func_function.name}`,
         retentionInDays: 5,
@@ -10,11 +10,14 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:lambda_function:docker-func-function
 const docker_func_function = aws.lambda.Function.get("docker-func-function", "preview(id=aws:lambda_function:docker-func-function)")
+// aws:rest_api:my-api-api
 const my_api_api = new aws.apigateway.RestApi("my-api-api", {
         binaryMediaTypes: ["application/octet-stream", "image/*"],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-api-api"},
     })
+// aws:api_method:my-api-api:docker-func-api_method
 const docker_func_api_method = new aws.apigateway.Method(
         "docker-func-api_method",
         {
@@ -27,12 +30,14 @@ const docker_func_api_method = new aws.apigateway.Method(
             parent: my_api_api
         }
     )
+// aws:lambda_permission:docker-func-docker-func-function
 const docker_func_docker_func_function = new aws.lambda.Permission("docker-func-docker-func-function", {
         action: "lambda:InvokeFunction",
         function: docker_func_function.name,
         principal: "apigateway.amazonaws.com",
         sourceArn: pulumi.interpolate`${my_api_api.executionArn}/*`,
     })
+// aws:api_integration:my-api-api:docker-func
 const docker_func = new aws.apigateway.Integration(
         "docker-func",
         {
@@ -45,6 +50,7 @@ const docker_func = new aws.apigateway.Integration(
         },
         { parent: docker_func_api_method }
     )
+// aws:api_deployment:my-api-api:api_deployment-0
 const api_deployment_0 = new aws.apigateway.Deployment(
         "api_deployment-0",
         {
@@ -55,6 +61,7 @@ const api_deployment_0 = new aws.apigateway.Deployment(
             dependsOn: [docker_func, docker_func_api_method, my_api_api],
         }
     )
+// aws:api_stage:my-api-api:my-api-stage
 const my_api_stage = new aws.apigateway.Stage("my-api-stage", {
         deployment: api_deployment_0.id,
         restApi: my_api_api.id,
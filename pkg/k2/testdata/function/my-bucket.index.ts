@@ -9,22 +9,37 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
-const my_bucket = new aws.s3.Bucket(
-        "my-bucket",
-        {
-            forceDestroy: true,
-            serverSideEncryptionConfiguration: {
-                rule: {
-                    applyServerSideEncryptionByDefault: {
-                        sseAlgorithm: "aws:kms",
+// aws:s3_bucket:my-bucket
+const my_bucket = (() => {
+        const bucket = new aws.s3.Bucket(
+            "my-bucket",
+            {
+                forceDestroy: true,
+                serverSideEncryptionConfiguration: {
+                    rule: {
+                        applyServerSideEncryptionByDefault: {
+                            sseAlgorithm: "aws:kms",
+                        },
+                        bucketKeyEnabled: true,
                     },
-                    bucketKeyEnabled: true,
+                },
+                tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-bucket"},
+            },
+            { protect: protect }
+        )
+
+        new aws.s3.BucketOwnershipControls(
+            `${"my-bucket"}-ownership-controls`,
+            {
+                bucket: bucket.id,
+                rule: {
+                    objectOwnership: "BucketOwnerEnforced",
                 },
             },
-            tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-bucket"},
-        },
-        { protect: protect }
-    )
+            { parent: bucket, protect: protect }
+        )
+        return bucket
+    })()
 export const my_bucket_BucketName = my_bucket.id
 
 export const $outputs = {
@@ -11,14 +11,16 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:s3_bucket:my-bucket
 const my_bucket = aws.s3.Bucket.get("my-bucket", "preview(id=aws:s3_bucket:my-bucket)")
 export const my_bucket_BucketName = my_bucket.id
+// aws:iam_role:zip-func-function-ExecutionRole
 const zip_func_function_executionrole = new aws.iam.Role("zip-func-function-ExecutionRole", {
         assumeRolePolicy: pulumi.jsonStringify({Statement: [{Action: ["sts:AssumeRole"], Effect: "Allow", Principal: {Service: ["lambda.amazonaws.com"]}}], Version: "2012-10-17"}),
         inlinePolicies: [
     {
         name: "my-bucket-policy",
-        policy: pulumi.jsonStringify({Statement: [{Action: ["s3:DescribeJob", "s3:Get*", "s3:List*"], Effect: "Allow", Resource: [my_bucket.arn, pulumi.interpolate`${my_bucket.arn}/*`]}], Version: "2012-10-17"})
+        policy: pulumi.jsonStringify({Statement: [{Action: ["s3:GetObject", "s3:ListBucket"], Effect: "Allow", Resource: [my_bucket.arn, pulumi.interpolate`${my_bucket.arn}/*`]}], Version: "2012-10-17"})
     },
 ],
         managedPolicyArns: [
@@ -26,6 +28,7 @@ const zip_func_function_executionrole = new aws.iam.Role("zip-func-function-Exec
         ],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "zip-func-function-ExecutionRole"},
     })
+// aws:lambda_function:zip-func-function
 const zip_func_function = new aws.lambda.Function(
         "zip-func-function",
         {
@@ -49,6 +52,7 @@ const zip_func_function = new aws.lambda.Function(
             dependsOn: [my_bucket, zip_func_function_executionrole],
         }
     )
+// aws:log_group:zip-func-function-log_group
 const zip_func_function_log_group = new aws.cloudwatch.LogGroup("zip-func-function-log_group", {
         name: pulumi.interpolate`/aws/lambda/${zip_func_function.name}`,
         retentionInDays: 5,
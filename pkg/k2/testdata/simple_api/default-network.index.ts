@@ -9,33 +9,41 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:elastic_ip:default-network-private-subnet-1-route_table-nat_gateway-elastic_ip
 const default_network_private_subnet_1_route_table_nat_gateway_elastic_ip = new aws.ec2.Eip("default-network-private-subnet-1-route_table-nat_gateway-elastic_ip", {
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-1-route_table-nat_gateway-elastic_ip"},
     })
+// aws:elastic_ip:default-network-private-subnet-2-route_table-nat_gateway-elastic_ip
 const default_network_private_subnet_2_route_table_nat_gateway_elastic_ip = new aws.ec2.Eip("default-network-private-subnet-2-route_table-nat_gateway-elastic_ip", {
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-2-route_table-nat_gateway-elastic_ip"},
     })
+// aws:region:region-0
 const region_0 = pulumi.output(aws.getRegion({}))
+// aws:vpc:default-network-vpc
 const default_network_vpc = new aws.ec2.Vpc("default-network-vpc", {
         cidrBlock: "10.0.0.0/16",
         enableDnsHostnames: true,
         enableDnsSupport: true,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-vpc"},
     })
+// aws:availability_zone:region-0:availability_zone-0
 const availability_zone_0 = pulumi.output(
         aws.getAvailabilityZones({
             state: 'available',
         })
     ).names[0]
+// aws:availability_zone:region-0:availability_zone-1
 const availability_zone_1 = pulumi.output(
         aws.getAvailabilityZones({
             state: 'available',
         })
     ).names[1]
+// aws:internet_gateway:default-network-vpc:internet_gateway-0
 const internet_gateway_0 = new aws.ec2.InternetGateway("internet_gateway-0", {
         vpcId: default_network_vpc.id,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "internet_gateway-0"},
     })
+// aws:subnet:default-network-vpc:default-network-private-subnet-1
 const default_network_private_subnet_1 = new aws.ec2.Subnet("default-network-private-subnet-1", {
         vpcId: default_network_vpc.id,
         cidrBlock: "10.0.128.0/18",
@@ -43,6 +51,7 @@ const default_network_private_subnet_1 = new aws.ec2.Subnet("default-network-pri
         mapPublicIpOnLaunch: false,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-1"},
     })
+// aws:subnet:default-network-vpc:default-network-public-subnet-1
 const default_network_public_subnet_1 = new aws.ec2.Subnet("default-network-public-subnet-1", {
         vpcId: default_network_vpc.id,
         cidrBlock: "10.0.0.0/18",
@@ -50,6 +59,7 @@ const default_network_public_subnet_1 = new aws.ec2.Subnet("default-network-publ
         mapPublicIpOnLaunch: false,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-public-subnet-1"},
     })
+// aws:subnet:default-network-vpc:default-network-private-subnet-2
 const default_network_private_subnet_2 = new aws.ec2.Subnet("default-network-private-subnet-2", {
         vpcId: default_network_vpc.id,
         cidrBlock: "10.0.192.0/18",
@@ -57,6 +67,7 @@ const default_network_private_subnet_2 = new aws.ec2.Subnet("default-network-pri
         mapPublicIpOnLaunch: false,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-2"},
     })
+// aws:subnet:default-network-vpc:default-network-public-subnet-2
 const default_network_public_subnet_2 = new aws.ec2.Subnet("default-network-public-subnet-2", {
         vpcId: default_network_vpc.id,
         cidrBlock: "10.0.64.0/18",
@@ -64,6 +75,7 @@ const default_network_public_subnet_2 = new aws.ec2.Subnet("default-network-publ
         mapPublicIpOnLaunch: false,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-public-subnet-2"},
     })
+// aws:route_table:default-network-vpc:default-network-public-subnet-1-route_table
 const default_network_public_subnet_1_route_table = new aws.ec2.RouteTable("default-network-public-subnet-1-route_table", {
         vpcId: default_network_vpc.id,
         routes: [
@@ -76,6 +88,7 @@ const default_network_public_subnet_1_route_table = new aws.ec2.RouteTable("defa
 ,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-public-subnet-1-route_table"},
     })
+// aws:route_table:default-network-vpc:default-network-public-subnet-2-route_table
 const default_network_public_subnet_2_route_table = new aws.ec2.RouteTable("default-network-public-subnet-2-route_table", {
         vpcId: default_network_vpc.id,
         routes: [
@@ -88,24 +101,31 @@ const default_network_public_subnet_2_route_table = new aws.ec2.RouteTable("defa
 ,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-public-subnet-2-route_table"},
     })
+// aws:nat_gateway:default-network-public-subnet-1:default-network-private-subnet-1-route_table-nat_gateway
 const default_network_private_subnet_1_route_table_nat_gateway = new aws.ec2.NatGateway("default-network-private-subnet-1-route_table-nat_gateway", {
         allocationId: default_network_private_subnet_1_route_table_nat_gateway_elastic_ip.id,
+        connectivityType: "public",
         subnetId: default_network_public_subnet_1.id,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-1-route_table-nat_gateway"},
     })
+// aws:nat_gateway:default-network-public-subnet-2:default-network-private-subnet-2-route_table-nat_gateway
 const default_network_private_subnet_2_route_table_nat_gateway = new aws.ec2.NatGateway("default-network-private-subnet-2-route_table-nat_gateway", {
         allocationId: default_network_private_subnet_2_route_table_nat_gateway_elastic_ip.id,
+        connectivityType: "public",
         subnetId: default_network_public_subnet_2.id,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-2-route_table-nat_gateway"},
     })
+// aws:route_table_association:default-network-public-subnet-1-default-network-public-subnet-1-route_table
 const default_network_public_subnet_1_default_network_public_subnet_1_route_table = new aws.ec2.RouteTableAssociation("default-network-public-subnet-1-default-network-public-subnet-1-route_table", {
         subnetId: default_network_public_subnet_1.id,
         routeTableId: default_network_public_subnet_1_route_table.id,
     })
+// aws:route_table_association:default-network-public-subnet-2-default-network-public-subnet-2-route_table
 const default_network_public_subnet_2_default_network_public_subnet_2_route_table = new aws.ec2.RouteTableAssociation("default-network-public-subnet-2-default-network-public-subnet-2-route_table", {
         subnetId: default_network_public_subnet_2.id,
         routeTableId: default_network_public_subnet_2_route_table.id,
     })
+// aws:route_table:default-network-vpc:default-network-private-subnet-1-route_table
 const default_network_private_subnet_1_route_table = new aws.ec2.RouteTable("default-network-private-subnet-1-route_table", {
         vpcId: default_network_vpc.id,
         routes: [
@@ -118,6 +138,7 @@ const default_network_private_subnet_1_route_table = new aws.ec2.RouteTable("def
 ,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-1-route_table"},
     })
+// aws:route_table:default-network-vpc:default-network-private-subnet-2-route_table
 const default_network_private_subnet_2_route_table = new aws.ec2.RouteTable("default-network-private-subnet-2-route_table", {
         vpcId: default_network_vpc.id,
         routes: [
@@ -130,10 +151,12 @@ const default_network_private_subnet_2_route_table = new aws.ec2.RouteTable("def
 ,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "default-network-private-subnet-2-route_table"},
     })
+// aws:route_table_association:default-network-private-subnet-1-default-network-private-subnet-1-route_table
 const default_network_private_subnet_1_default_network_private_subnet_1_route_table = new aws.ec2.RouteTableAssociation("default-network-private-subnet-1-default-network-private-subnet-1-route_table", {
         subnetId: default_network_private_subnet_1.id,
         routeTableId: default_network_private_subnet_1_route_table.id,
     })
+// aws:route_table_association:default-network-private-subnet-2-default-network-private-subnet-2-route_table
 const default_network_private_subnet_2_default_network_private_subnet_2_route_table = new aws.ec2.RouteTableAssociation("default-network-private-subnet-2-default-network-private-subnet-2-route_table", {
         subnetId: default_network_private_subnet_2.id,
         routeTableId: default_network_private_subnet_2_route_table.id,
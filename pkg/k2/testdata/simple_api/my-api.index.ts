@@ -11,12 +11,16 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:ecs_cluster:ecs_cluster-0
 const ecs_cluster_0 = aws.ecs.Cluster.get("ecs_cluster-0", "preview(id=aws:ecs_cluster:ecs_cluster-0)")
+// aws:rest_api:my-api-api
 const my_api_api = new aws.apigateway.RestApi("my-api-api", {
         binaryMediaTypes: ["application/octet-stream", "image/*"],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-api-api"},
     })
+// aws:vpc:default-network-vpc
 const default_network_vpc = aws.ec2.Vpc.get("default-network-vpc", "preview(id=aws:vpc:default-network-vpc)")
+// aws:api_method:my-api-api:--any-method
 const __any_method = new aws.apigateway.Method(
         "--any-method",
         {
@@ -29,19 +33,29 @@ const __any_method = new aws.apigateway.Method(
             parent: my_api_api
         }
     )
+// aws:security_group:default-network-vpc:my-container-service-security_group
 const my_container_service_security_group = aws.ec2.SecurityGroup.get("my-container-service-security_group", "preview(id=aws:security_group:default-network-vpc:my-container-service-security_group)")
+// aws:subnet:default-network-vpc:default-network-private-subnet-1
 const default_network_private_subnet_1 = aws.ec2.Subnet.get("default-network-private-subnet-1", "preview(id=aws:subnet:default-network-vpc:default-network-private-subnet-1)")
+// aws:subnet:default-network-vpc:default-network-private-subnet-2
 const default_network_private_subnet_2 = aws.ec2.Subnet.get("default-network-private-subnet-2", "preview(id=aws:subnet:default-network-vpc:default-network-private-subnet-2)")
+// aws:subnet:default-network-vpc:default-network-public-subnet-1
 const default_network_public_subnet_1 = aws.ec2.Subnet.get("default-network-public-subnet-1", "preview(id=aws:subnet:default-network-vpc:default-network-public-subnet-1)")
+// aws:subnet:default-network-vpc:default-network-public-subnet-2
 const default_network_public_subnet_2 = aws.ec2.Subnet.get("default-network-public-subnet-2", "preview(id=aws:subnet:default-network-vpc:default-network-public-subnet-2)")
+// aws:target_group:my-container-tg
 const my_container_tg = aws.lb.TargetGroup.get("my-container-tg", "preview(id=aws:target_group:my-container-tg)")
+// aws:load_balancer:api-my-container-lb
 const api_my_container_lb = aws.lb.LoadBalancer.get("api-my-container-lb", "preview(id=aws:load_balancer:api-my-container-lb)")
 export const api_my_container_lb_DomainName = api_my_container_lb.dnsName
+// aws:ecs_service:my-container-service
 const my_container_service = aws.ecs.Service.get("my-container-service", "preview(id=aws:ecs_service:my-container-service)".split('/').slice(-2).join('/'))
+// aws:vpc_link:--any-integration-api-my-container-lb
 const __any_integration_api_my_container_lb = new aws.apigateway.VpcLink("--any-integration-api-my-container-lb", {
         targetArn: api_my_container_lb.arn,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "--any-integration-api-my-container-lb"},
     })
+// aws:api_integration:my-api-api:--any-integration
 const __any_integration = new aws.apigateway.Integration(
         "--any-integration",
         {
@@ -58,6 +72,7 @@ const __any_integration = new aws.apigateway.Integration(
         },
         { parent: __any_method }
     )
+// aws:api_deployment:my-api-api:api_deployment-0
 const api_deployment_0 = new aws.apigateway.Deployment(
         "api_deployment-0",
         {
@@ -68,6 +83,7 @@ const api_deployment_0 = new aws.apigateway.Deployment(
             dependsOn: [__any_integration, __any_method, my_api_api],
         }
     )
+// aws:api_stage:my-api-api:my-api-stage
 const my_api_stage = new aws.apigateway.Stage("my-api-stage", {
         deployment: api_deployment_0.id,
         restApi: my_api_api.id,
@@ -12,6 +12,7 @@ const awsProfile = awsConfig.get('profile')
 const accountId = pulumi.output(aws.getCallerIdentity({}))
 const region = pulumi.output(aws.getRegion({}))
 
+// aws:ecr_repo:my-container-image-ecr_repo
 const my_container_image_ecr_repo = new aws.ecr.Repository("my-container-image-ecr_repo", {
         imageScanningConfiguration: {
             scanOnPush: true,
@@ -21,10 +22,12 @@ const my_container_image_ecr_repo = new aws.ecr.Repository("my-container-image-e
         encryptionConfigurations: [{ encryptionType: 'KMS' }],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-image-ecr_repo"},
     })
+// aws:ecs_cluster:ecs_cluster-0
 const ecs_cluster_0 = new aws.ecs.Cluster("ecs_cluster-0", {
         settings: [{name: "containerInsights", value: "enabled"}],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "ecs_cluster-0"},
     })
+// aws:iam_role:my-container-task-execution-role
 const my_container_task_execution_role = new aws.iam.Role("my-container-task-execution-role", {
         assumeRolePolicy: pulumi.jsonStringify({Statement: [{Action: ["sts:AssumeRole"], Effect: "Allow", Principal: {Service: ["ecs-tasks.amazonaws.com"]}}], Version: "2012-10-17"}),
         managedPolicyArns: [
@@ -32,13 +35,17 @@ const my_container_task_execution_role = new aws.iam.Role("my-container-task-exe
         ],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-task-execution-role"},
     })
+// aws:log_group:my-container-task-log-group
 const my_container_task_log_group = new aws.cloudwatch.LogGroup("my-container-task-log-group", {
         name: "/aws/ecs/my-container-task",
         retentionInDays: 5,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-task-log-group"},
     })
+// aws:region:region-0
 const region_0 = pulumi.output(aws.getRegion({}))
+// aws:vpc:default-network-vpc
 const default_network_vpc = aws.ec2.Vpc.get("default-network-vpc", "preview(id=aws:vpc:default-network-vpc)")
+// aws:ecr_image:my-container-image
 const my_container_image = (() => {
         const base = new docker.Image(`${"my-container-image"}-base`, {
             build: {
@@ -82,6 +89,7 @@ const my_container_image = (() => {
             { parent: base }
         )
     })()
+// aws:security_group:default-network-vpc:my-container-service-security_group
 const my_container_service_security_group = new aws.ec2.SecurityGroup("my-container-service-security_group", {
         name: "my-container-service-security_group",
         vpcId: default_network_vpc.id,
@@ -89,8 +97,11 @@ const my_container_service_security_group = new aws.ec2.SecurityGroup("my-contai
         ingress: [{cidrBlocks: ["10.0.128.0/18"], description: "Allow ingress traffic from ip addresses within the subnet default-network-private-subnet-1", fromPort: 0, protocol: "-1", toPort: 0}, {cidrBlocks: ["10.0.192.0/18"], description: "Allow ingress traffic from ip addresses within the subnet default-network-private-subnet-2", fromPort: 0, protocol: "-1", toPort: 0}, {description: "Allow ingress traffic from within the same security group", fromPort: 0, protocol: "-1", self: true, toPort: 0}],
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-service-security_group"},
     })
+// aws:subnet:default-network-vpc:default-network-public-subnet-1
 const default_network_public_subnet_1 = aws.ec2.Subnet.get("default-network-public-subnet-1", "preview(id=aws:subnet:default-network-vpc:default-network-public-subnet-1)")
+// aws:subnet:default-network-vpc:default-network-public-subnet-2
 const default_network_public_subnet_2 = aws.ec2.Subnet.get("default-network-public-subnet-2", "preview(id=aws:subnet:default-network-vpc:default-network-public-subnet-2)")
+// aws:target_group:my-container-tg
 const my_container_tg = (() => {
         const tg = new aws.lb.TargetGroup("my-container-tg", {
             port: 80,
@@ -109,6 +120,7 @@ const my_container_tg = (() => {
         })
         return tg
     })()
+// aws:ecs_task_definition:my-container-task
 const my_container_task = new aws.ecs.TaskDefinition("my-container-task", {
         family: "my-container-task",
         cpu: "256",
@@ -143,8 +155,11 @@ const my_container_task = new aws.ecs.TaskDefinition("my-container-task", {
 ]),
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-task"},
     })
+// aws:subnet:default-network-vpc:default-network-private-subnet-1
 const default_network_private_subnet_1 = aws.ec2.Subnet.get("default-network-private-subnet-1", "preview(id=aws:subnet:default-network-vpc:default-network-private-subnet-1)")
+// aws:subnet:default-network-vpc:default-network-private-subnet-2
 const default_network_private_subnet_2 = aws.ec2.Subnet.get("default-network-private-subnet-2", "preview(id=aws:subnet:default-network-vpc:default-network-private-subnet-2)")
+// aws:ecs_service:my-container-service
 const my_container_service = new aws.ecs.Service(
         "my-container-service",
         {
@@ -171,6 +186,7 @@ const my_container_service = new aws.ecs.Service(
         },
         { dependsOn: [default_network_private_subnet_1, default_network_private_subnet_2, ecs_cluster_0, my_container_service_security_group, my_container_task, my_container_tg] }
     )
+// aws:load_balancer:api-my-container-lb
 const api_my_container_lb = new aws.lb.LoadBalancer("api-my-container-lb", {
         internal: true,
         loadBalancerType: "network",
@@ -178,6 +194,7 @@ const api_my_container_lb = new aws.lb.LoadBalancer("api-my-container-lb", {
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "api-my-container-lb"},
     })
 export const api_my_container_lb_DomainName = api_my_container_lb.dnsName
+// aws:cloudwatch_alarm:my-container-service-CPUUtilization
 const my_container_service_cpuutilization = new aws.cloudwatch.MetricAlarm("my-container-service-CPUUtilization", {
         comparisonOperator: "GreaterThanOrEqualToThreshold",
         evaluationPeriods: 2,
@@ -191,6 +208,7 @@ const my_container_service_cpuutilization = new aws.cloudwatch.MetricAlarm("my-c
         threshold: 90,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-service-CPUUtilization"},
     })
+// aws:cloudwatch_alarm:my-container-service-MemoryUtilization
 const my_container_service_memoryutilization = new aws.cloudwatch.MetricAlarm("my-container-service-MemoryUtilization", {
         comparisonOperator: "GreaterThanOrEqualToThreshold",
         evaluationPeriods: 2,
@@ -204,6 +222,7 @@ const my_container_service_memoryutilization = new aws.cloudwatch.MetricAlarm("m
         threshold: 90,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-service-MemoryUtilization"},
     })
+// aws:cloudwatch_alarm:my-container-service-RunningTaskCount
 const my_container_service_runningtaskcount = new aws.cloudwatch.MetricAlarm("my-container-service-RunningTaskCount", {
         comparisonOperator: "LessThanThreshold",
         evaluationPeriods: 1,
@@ -217,6 +236,7 @@ const my_container_service_runningtaskcount = new aws.cloudwatch.MetricAlarm("my
         threshold: 1,
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "my-container-service-RunningTaskCount"},
     })
+// aws:load_balancer_listener:api-my-container-lb:api-my-container-lb-listener
 const api_my_container_lb_listener = new aws.lb.Listener("api-my-container-lb-listener", {
         loadBalancerArn: api_my_container_lb.arn,
         defaultActions: [
@@ -231,6 +251,7 @@ const api_my_container_lb_listener = new aws.lb.Listener("api-my-container-lb-li
         protocol: "TCP",
         tags: {GLOBAL_KLOTHO_TAG: "k2", RESOURCE_NAME: "api-my-container-lb-listener"},
     })
+// aws:cloudwatch_dashboard:cloudwatch_dashboard-0
 const cloudwatch_dashboard_0 = new aws.cloudwatch.Dashboard("cloudwatch_dashboard-0", {
         dashboardName: "cloudwatch_dashboard-0",
         dashboardBody: pulumi.jsonStringify({widgets: [{height: 6, properties: {annotations: {alarms: [my_container_service_cpuutilization.arn]}, region: region_0.apply((o) => o.name)}, type: "metric", width: 6}, {height: 6, properties: {alarms: [my_container_service_cpuutilization.arn]}, type: "alarm", width: 6}, {height: 6, properties: {annotations: {alarms: [my_container_service_memoryutilization.arn]}, region: region_0.apply((o) => o.name)}, type: "metric", width: 6}, {height: 6, properties: {alarms: [my_container_service_memoryutilization.arn]}, type: "alarm", width: 6}, {height: 6, properties: {annotations: {alarms: [my_container_service_runningtaskcount.arn]}, region: region_0.apply((o) => o.name)}, type: "metric", width: 6}, {height: 6, properties: {alarms: [my_container_service_runningtaskcount.arn]}, type: "alarm", width: 6}]}),
@@ -70,6 +70,7 @@ func (ctx DynamicValueContext) TemplateFunctions() template.FuncMap {
 		"fieldRef":           ctx.FieldRef,
 		"pathAncestor":       ctx.PathAncestor,
 		"pathAncestorExists": ctx.PathAncestorExists,
+		"accessActions":      ctx.AccessActions,
 
 		"toJson": ctx.toJson,
 
@@ -554,6 +555,28 @@ func (ctx DynamicValueContext) FieldRef(field string, resource any) (construct.P
 	}, nil
 }
 
+// AccessActions returns the minimal actions for the `intent` ("read", "write" or "admin") on the resource,
+// as defined by the resource template's access permissions.
+// Example: `{{ accessActions "read" .Target | toJson }}`
+func (ctx DynamicValueContext) AccessActions(intent string, resource any) ([]string, error) {
+	resId, err := TemplateArgToRID(resource)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := ctx.KB().GetResourceTemplate(resId)
+	if err != nil {
+		return nil, err
+	}
+	if rt == nil {
+		return nil, fmt.Errorf("resource template not found for resource %s", resId)
+	}
+	actions, err := rt.AccessPermissions.Actions(AccessIntent(intent))
+	if err != nil {
+		return nil, fmt.Errorf("could not get access actions for %s: %w", resId, err)
+	}
+	return actions, nil
+}
+
 // toJson is used to return complex values that do not have TextUnmarshaler implemented
 func (ctx DynamicValueContext) toJson(value any) (string, error) {
 	j, err := json.Marshal(value)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"text/template"
 
 	"github.com/dominikbraun/graph"
@@ -37,6 +38,21 @@ type (
 	KnowledgeBase struct {
 		underlying Graph
 		Models     map[string]*Model
+
+		// pathCache memoizes the paths found between types (see [KnowledgeBase.CachedPaths]). The paths only change
+		// when templates are added, which clears the cache.
+		pathCacheLock sync.RWMutex
+		pathCache     map[PathCacheKey][][]string
+
+		// providerEdges partitions the edge templates by provider once enabled by [KnowledgeBase.SplitByProvider].
+		// Each edge is in the partitions of both its source's and its target's provider.
+		providerEdges map[string]*providerEdges
+	}
+
+	// PathCacheKey identifies the paths between a source and target type which satisfy a classification.
+	PathCacheKey struct {
+		From, To       string
+		Classification string
 	}
 
 	EdgePathSatisfaction struct {
@@ -125,6 +141,7 @@ func (kb *KnowledgeBase) Edges() ([]graph.Edge[*ResourceTemplate], error) {
 }
 
 func (kb *KnowledgeBase) AddResourceTemplate(template *ResourceTemplate) error {
+	kb.ClearPathCache()
 	return kb.underlying.AddVertex(template)
 }
 
@@ -145,12 +162,18 @@ func (kb *KnowledgeBase) AddEdgeTemplate(template *EdgeTemplate) error {
 			weight = functionalBoundaryEdgeWeight
 		}
 	}
-	return kb.underlying.AddEdge(
+	kb.ClearPathCache()
+	err = kb.underlying.AddEdge(
 		template.Source.QualifiedTypeName(),
 		template.Target.QualifiedTypeName(),
 		graph.EdgeData(template),
 		graph.EdgeWeight(weight),
 	)
+	if err != nil || kb.providerEdges == nil {
+		return err
+	}
+	kb.addProviderEdge(template)
+	return nil
 }
 
 func (kb *KnowledgeBase) GetResourceTemplate(id construct.ResourceId) (*ResourceTemplate, error) {
@@ -228,6 +251,38 @@ func (kb *KnowledgeBase) AllPaths(from, to construct.ResourceId) ([][]*ResourceT
 	return resources, nil
 }
 
+// CachedPaths returns the paths of template types cached for the key, calling find to compute (and cache) them on
+// the first lookup. The paths are shared between callers and must not be modified.
+func (kb *KnowledgeBase) CachedPaths(key PathCacheKey, find func() ([][]string, error)) ([][]string, error) {
+	kb.pathCacheLock.RLock()
+	cached, ok := kb.pathCache[key]
+	kb.pathCacheLock.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	paths, err := find()
+	if err != nil {
+		return nil, err
+	}
+
+	kb.pathCacheLock.Lock()
+	defer kb.pathCacheLock.Unlock()
+	if kb.pathCache == nil {
+		kb.pathCache = make(map[PathCacheKey][][]string)
+	}
+	kb.pathCache[key] = paths
+	return paths, nil
+}
+
+// ClearPathCache clears the paths cached by [KnowledgeBase.CachedPaths]. Adding templates through the knowledge base
+// clears the cache automatically, so this is only needed if the underlying graph is modified directly.
+func (kb *KnowledgeBase) ClearPathCache() {
+	kb.pathCacheLock.Lock()
+	defer kb.pathCacheLock.Unlock()
+	kb.pathCache = nil
+}
+
 func (kb *KnowledgeBase) GetAllowedNamespacedResourceIds(ctx DynamicValueContext, resourceId construct.ResourceId) ([]construct.ResourceId, error) {
 
 	template, err := kb.GetResourceTemplate(resourceId)
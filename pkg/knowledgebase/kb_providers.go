@@ -0,0 +1,123 @@
+package knowledgebase
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// providerEdges indexes a provider's edge templates by their source and target types. Each list is sorted by the
+// other end's type.
+type providerEdges struct {
+	bySource map[string][]*EdgeTemplate
+	byTarget map[string][]*EdgeTemplate
+}
+
+// SplitByProvider partitions the knowledge base's edge templates by provider, so that [KnowledgeBase.GetEdgesWithSource]
+// and [KnowledgeBase.GetEdgesWithTarget] only look up the edges of the resource's provider instead of scanning every
+// edge. Edge templates added afterwards are added to their partitions.
+func (kb *KnowledgeBase) SplitByProvider() error {
+	edges, err := kb.underlying.Edges()
+	if err != nil {
+		return err
+	}
+	kb.providerEdges = make(map[string]*providerEdges)
+	for _, e := range edges {
+		if template, ok := e.Properties.Data.(*EdgeTemplate); ok {
+			kb.addProviderEdge(template)
+		}
+	}
+	return nil
+}
+
+// IsSplitByProvider returns whether [KnowledgeBase.SplitByProvider] partitioned the knowledge base's edge templates.
+func (kb *KnowledgeBase) IsSplitByProvider() bool {
+	return kb.providerEdges != nil
+}
+
+// addProviderEdge adds the edge template to the partitions of both its source's and its target's provider, so
+// cross-provider edges are found from either side.
+func (kb *KnowledgeBase) addProviderEdge(template *EdgeTemplate) {
+	providers := []string{template.Source.Provider}
+	if p := template.Target.Provider; p != providers[0] {
+		providers = append(providers, p)
+	}
+	source, target := template.Source.QualifiedTypeName(), template.Target.QualifiedTypeName()
+	for _, provider := range providers {
+		edges, ok := kb.providerEdges[provider]
+		if !ok {
+			edges = &providerEdges{
+				bySource: make(map[string][]*EdgeTemplate),
+				byTarget: make(map[string][]*EdgeTemplate),
+			}
+			kb.providerEdges[provider] = edges
+		}
+		edges.bySource[source] = insertEdge(edges.bySource[source], template, func(et *EdgeTemplate) string {
+			return et.Target.QualifiedTypeName()
+		})
+		edges.byTarget[target] = insertEdge(edges.byTarget[target], template, func(et *EdgeTemplate) string {
+			return et.Source.QualifiedTypeName()
+		})
+	}
+}
+
+// insertEdge inserts the template into the list, sorted by key.
+func insertEdge(list []*EdgeTemplate, template *EdgeTemplate, key func(*EdgeTemplate) string) []*EdgeTemplate {
+	i, _ := slices.BinarySearchFunc(list, key(template), func(et *EdgeTemplate, k string) int {
+		switch {
+		case key(et) < k:
+			return -1
+		case key(et) > k:
+			return 1
+		}
+		return 0
+	})
+	return slices.Insert(list, i, template)
+}
+
+// GetEdgesWithSource returns the edge templates from the resource's type, sorted by their target.
+func (kb *KnowledgeBase) GetEdgesWithSource(source construct.ResourceId) ([]*EdgeTemplate, error) {
+	if kb.providerEdges != nil {
+		if edges, ok := kb.providerEdges[source.Provider]; ok {
+			return slices.Clone(edges.bySource[source.QualifiedTypeName()]), nil
+		}
+		return nil, nil
+	}
+	adj, err := kb.underlying.AdjacencyMap()
+	if err != nil {
+		return nil, err
+	}
+	return edgeTemplates(adj[source.QualifiedTypeName()]), nil
+}
+
+// GetEdgesWithTarget returns the edge templates to the resource's type, sorted by their source.
+func (kb *KnowledgeBase) GetEdgesWithTarget(target construct.ResourceId) ([]*EdgeTemplate, error) {
+	if kb.providerEdges != nil {
+		if edges, ok := kb.providerEdges[target.Provider]; ok {
+			return slices.Clone(edges.byTarget[target.QualifiedTypeName()]), nil
+		}
+		return nil, nil
+	}
+	pred, err := kb.underlying.PredecessorMap()
+	if err != nil {
+		return nil, err
+	}
+	return edgeTemplates(pred[target.QualifiedTypeName()]), nil
+}
+
+func edgeTemplates(edges map[string]graph.Edge[string]) []*EdgeTemplate {
+	keys := make([]string, 0, len(edges))
+	for k := range edges {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var templates []*EdgeTemplate
+	for _, k := range keys {
+		if template, ok := edges[k].Properties.Data.(*EdgeTemplate); ok {
+			templates = append(templates, template)
+		}
+	}
+	return templates
+}
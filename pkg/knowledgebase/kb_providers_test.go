@@ -0,0 +1,131 @@
+package knowledgebase
+
+import (
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingGraph counts the lookups which scan the graph's edges.
+type countingGraph struct {
+	Graph
+	scans int
+}
+
+func (g *countingGraph) AdjacencyMap() (map[string]map[string]graph.Edge[string], error) {
+	g.scans++
+	return g.Graph.AdjacencyMap()
+}
+
+func (g *countingGraph) PredecessorMap() (map[string]map[string]graph.Edge[string], error) {
+	g.scans++
+	return g.Graph.PredecessorMap()
+}
+
+func (g *countingGraph) Edges() ([]graph.Edge[string], error) {
+	g.scans++
+	return g.Graph.Edges()
+}
+
+func newProvidersKB(t *testing.T) *KnowledgeBase {
+	kb := NewKB()
+	for _, typ := range []string{"aws:lambda_function", "aws:iam_role", "aws:ecr_image", "kubernetes:deployment", "kubernetes:service"} {
+		require.NoError(t, kb.AddResourceTemplate(&ResourceTemplate{QualifiedTypeName: typ}))
+	}
+	for _, e := range [][2]string{
+		{"aws:lambda_function", "aws:iam_role"},
+		{"aws:lambda_function", "aws:ecr_image"},
+		{"kubernetes:service", "kubernetes:deployment"},
+		{"kubernetes:deployment", "aws:ecr_image"},
+	} {
+		var source, target construct.ResourceId
+		require.NoError(t, source.Parse(e[0]))
+		require.NoError(t, target.Parse(e[1]))
+		require.NoError(t, kb.AddEdgeTemplate(&EdgeTemplate{Source: source, Target: target}))
+	}
+	return kb
+}
+
+func edgeNames(templates []*EdgeTemplate) []string {
+	var names []string
+	for _, et := range templates {
+		names = append(names, et.Source.QualifiedTypeName()+" -> "+et.Target.QualifiedTypeName())
+	}
+	return names
+}
+
+func TestGetEdges_SplitByProvider(t *testing.T) {
+	lambda := construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"}
+	image := construct.ResourceId{Provider: "aws", Type: "ecr_image", Name: "image"}
+	service := construct.ResourceId{Provider: "kubernetes", Type: "service", Name: "svc"}
+
+	for _, split := range []bool{false, true} {
+		name := "unsplit"
+		if split {
+			name = "split"
+		}
+		t.Run(name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			kb := newProvidersKB(t)
+			if split {
+				require.NoError(kb.SplitByProvider())
+			}
+
+			edges, err := kb.GetEdgesWithSource(lambda)
+			require.NoError(err)
+			assert.Equal([]string{"aws:lambda_function -> aws:ecr_image", "aws:lambda_function -> aws:iam_role"}, edgeNames(edges))
+
+			// the edge from kubernetes is found by its aws target
+			edges, err = kb.GetEdgesWithTarget(image)
+			require.NoError(err)
+			assert.Equal([]string{"aws:lambda_function -> aws:ecr_image", "kubernetes:deployment -> aws:ecr_image"}, edgeNames(edges))
+
+			edges, err = kb.GetEdgesWithSource(service)
+			require.NoError(err)
+			assert.Equal([]string{"kubernetes:service -> kubernetes:deployment"}, edgeNames(edges))
+		})
+	}
+
+	t.Run("split lookups don't scan the edges", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		kb := newProvidersKB(t)
+		require.NoError(kb.SplitByProvider())
+		counting := &countingGraph{Graph: kb.underlying}
+		kb.underlying = counting
+
+		_, err := kb.GetEdgesWithSource(lambda)
+		require.NoError(err)
+		_, err = kb.GetEdgesWithTarget(image)
+		require.NoError(err)
+		_, err = kb.GetEdgesWithSource(service)
+		require.NoError(err)
+		assert.Zero(counting.scans)
+
+		// aws lookups only go through aws edges (and the cross-provider edge to aws)
+		var awsSources []string
+		for source := range kb.providerEdges["aws"].bySource {
+			awsSources = append(awsSources, source)
+		}
+		assert.ElementsMatch([]string{"aws:lambda_function", "kubernetes:deployment"}, awsSources)
+	})
+
+	t.Run("templates added after the split", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		kb := newProvidersKB(t)
+		require.NoError(kb.SplitByProvider())
+		require.NoError(kb.AddEdgeTemplate(&EdgeTemplate{
+			Source: construct.ResourceId{Provider: "kubernetes", Type: "deployment"},
+			Target: construct.ResourceId{Provider: "aws", Type: "iam_role"},
+		}))
+
+		edges, err := kb.GetEdgesWithTarget(construct.ResourceId{Provider: "aws", Type: "iam_role"})
+		require.NoError(err)
+		assert.Equal([]string{"aws:lambda_function -> aws:iam_role", "kubernetes:deployment -> aws:iam_role"}, edgeNames(edges))
+	})
+}
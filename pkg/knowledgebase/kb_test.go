@@ -0,0 +1,50 @@
+package knowledgebase
+
+import (
+	"testing"
+
+	construct "github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedPaths(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	kb := NewKB()
+	for _, typ := range []string{"a", "b"} {
+		require.NoError(kb.AddResourceTemplate(&ResourceTemplate{QualifiedTypeName: "p:" + typ}))
+	}
+	finds := 0
+	find := func() ([][]string, error) {
+		finds++
+		return [][]string{{"p:a", "p:b"}}, nil
+	}
+	key := PathCacheKey{From: "p:a", To: "p:b", Classification: "network"}
+
+	paths, err := kb.CachedPaths(key, find)
+	require.NoError(err)
+	assert.Equal([][]string{{"p:a", "p:b"}}, paths)
+	_, err = kb.CachedPaths(key, find)
+	require.NoError(err)
+	assert.Equal(1, finds)
+
+	// a different classification is cached separately
+	_, err = kb.CachedPaths(PathCacheKey{From: "p:a", To: "p:b"}, find)
+	require.NoError(err)
+	assert.Equal(2, finds)
+
+	// adding an edge template invalidates the cache
+	require.NoError(kb.AddEdgeTemplate(&EdgeTemplate{
+		Source: construct.ResourceId{Provider: "p", Type: "a"},
+		Target: construct.ResourceId{Provider: "p", Type: "b"},
+	}))
+	_, err = kb.CachedPaths(key, find)
+	require.NoError(err)
+	assert.Equal(3, finds)
+
+	kb.ClearPathCache()
+	_, err = kb.CachedPaths(key, find)
+	require.NoError(err)
+	assert.Equal(4, finds)
+}
@@ -113,7 +113,7 @@ func (a *AnyProperty) Validate(resource *construct.Resource, value any, ctx know
 	if a.DeployTime && value == nil && !resource.Imported {
 		return nil
 	}
-	if a.Required && value == nil {
+	if requiresValue(a.PropertyDetails, resource) && value == nil {
 		return fmt.Errorf(knowledgebase.ErrRequiredProperty, a.Path, resource.ID)
 	}
 	return nil
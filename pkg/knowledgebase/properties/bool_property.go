@@ -88,7 +88,7 @@ func (b *BoolProperty) Validate(resource *construct.Resource, value any, ctx kno
 		return nil
 	}
 	if value == nil {
-		if b.Required {
+		if requiresValue(b.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, b.Path, resource.ID)
 		}
 		return nil
@@ -96,20 +96,26 @@ func (f *FloatProperty) Validate(resource *construct.Resource, value any, ctx kn
 		return nil
 	}
 	if value == nil {
-		if f.Required {
+		if requiresValue(f.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, f.Path, resource.ID)
 		}
 		return nil
 	}
-	floatVal, ok := value.(float64)
-	if !ok {
+	var floatVal float64
+	switch v := value.(type) {
+	case float64:
+		floatVal = v
+	case float32:
+		// templated values are parsed as float32
+		floatVal = float64(v)
+	default:
 		return fmt.Errorf("invalid float value %v", value)
 	}
 	if f.MinValue != nil && floatVal < *f.MinValue {
-		return fmt.Errorf("float value %f is less than lower bound %f", value, *f.MinValue)
+		return fmt.Errorf("float value %f is less than lower bound %f", floatVal, *f.MinValue)
 	}
 	if f.MaxValue != nil && floatVal > *f.MaxValue {
-		return fmt.Errorf("float value %f is greater than upper bound %f", value, *f.MaxValue)
+		return fmt.Errorf("float value %f is greater than upper bound %f", floatVal, *f.MaxValue)
 	}
 	return nil
 }
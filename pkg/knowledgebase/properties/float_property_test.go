@@ -197,6 +197,7 @@ func Test_ParseFloatValue(t *testing.T) {
 }
 
 func Test_FloatProperty_Validate(t *testing.T) {
+	upperBound := 90.0
 	tests := []struct {
 		name          string
 		property      *FloatProperty
@@ -214,6 +215,26 @@ func Test_FloatProperty_Validate(t *testing.T) {
 			},
 			value: 1.0,
 		},
+		{
+			name: "templated float value",
+			property: &FloatProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{
+					Path: "test",
+				},
+			},
+			value: float32(70),
+		},
+		{
+			name: "templated float value out of bounds",
+			property: &FloatProperty{
+				MaxValue: &upperBound,
+				PropertyDetails: knowledgebase.PropertyDetails{
+					Path: "test",
+				},
+			},
+			value:   float32(95),
+			wantErr: true,
+		},
 		{
 			name: "int value",
 			property: &FloatProperty{
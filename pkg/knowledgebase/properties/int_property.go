@@ -106,7 +106,7 @@ func (i *IntProperty) Validate(resource *construct.Resource, value any, ctx know
 		return nil
 	}
 	if value == nil {
-		if i.Required {
+		if requiresValue(i.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, i.Path, resource.ID)
 		}
 		return nil
@@ -174,7 +174,7 @@ func (l *ListProperty) Validate(resource *construct.Resource, value any, ctx kno
 		return nil
 	}
 	if value == nil {
-		if l.Required {
+		if requiresValue(l.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, l.Path, resource.ID)
 		}
 		return nil
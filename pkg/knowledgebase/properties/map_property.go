@@ -194,7 +194,7 @@ func (m *MapProperty) Validate(resource *construct.Resource, value any, ctx know
 		return nil
 	}
 	if value == nil {
-		if m.Required {
+		if requiresValue(m.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, m.Path, resource.ID)
 		}
 		return nil
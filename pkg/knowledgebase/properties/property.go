@@ -24,6 +24,26 @@ type (
 	}
 )
 
+// requiresValue returns whether the property must be set on the resource. An imported resource already exists, so
+// only its deploy-time properties, which identify the existing resource (such as its Id), are required.
+func requiresValue(details knowledgebase.PropertyDetails, resource *construct.Resource) bool {
+	return details.Required && (details.DeployTime || !resource.Imported)
+}
+
+// isEmptyDefault returns whether a templated default value renders empty. A conditional default which renders
+// empty (such as one which only applies to some configurations) means there is no default.
+func isEmptyDefault(value any, ctx knowledgebase.DynamicContext, data knowledgebase.DynamicValueData) (bool, error) {
+	tmpl, ok := value.(string)
+	if !ok {
+		return false, nil
+	}
+	var rendered string
+	if err := ctx.ExecuteDecode(tmpl, data, &rendered); err != nil {
+		return false, err
+	}
+	return rendered == "", nil
+}
+
 func ParsePropertyRef(value any, ctx knowledgebase.DynamicContext, data knowledgebase.DynamicValueData) (construct.PropertyRef, error) {
 	if val, ok := value.(string); ok {
 		result := construct.PropertyRef{}
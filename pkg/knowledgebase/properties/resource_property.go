@@ -62,6 +62,9 @@ func (r *ResourceProperty) GetDefaultValue(ctx knowledgebase.DynamicContext, dat
 	if r.DefaultValue == nil {
 		return nil, nil
 	}
+	if empty, err := isEmptyDefault(r.DefaultValue, ctx, data); empty || err != nil {
+		return nil, err
+	}
 	return r.Parse(r.DefaultValue, ctx, data)
 }
 
@@ -135,7 +138,7 @@ func (r *ResourceProperty) Validate(resource *construct.Resource, value any, ctx
 		return nil
 	}
 	if value == nil {
-		if r.Required {
+		if requiresValue(r.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, r.Path, resource.ID)
 		}
 		return nil
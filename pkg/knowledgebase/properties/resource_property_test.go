@@ -143,7 +143,7 @@ func Test_ResourceProperty_GetDefaultValue(t *testing.T) {
 		property *ResourceProperty
 		ctx      knowledgebase2.DynamicValueContext
 		data     knowledgebase2.DynamicValueData
-		want     construct.ResourceId
+		want     any
 		wantErr  bool
 	}{
 		{
@@ -164,6 +164,15 @@ func Test_ResourceProperty_GetDefaultValue(t *testing.T) {
 			},
 			want: construct.ResourceId{Provider: "mock", Type: "resource", Name: "r1"},
 		},
+		{
+			name: "conditional default renders empty",
+			property: &ResourceProperty{
+				SharedPropertyFields: SharedPropertyFields{
+					DefaultValue: "{{ if false }}mock:resource:r1{{ end }}",
+				},
+			},
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,7 +164,7 @@ func (s *SetProperty) Validate(resource *construct.Resource, value any, ctx know
 		return nil
 	}
 	if value == nil {
-		if s.Required {
+		if requiresValue(s.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, s.Path, resource.ID)
 		}
 		return nil
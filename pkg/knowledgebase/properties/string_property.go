@@ -55,6 +55,9 @@ func (s *StringProperty) GetDefaultValue(ctx knowledgebase.DynamicContext, data
 	if s.DefaultValue == nil {
 		return nil, nil
 	}
+	if empty, err := isEmptyDefault(s.DefaultValue, ctx, data); empty || err != nil {
+		return nil, err
+	}
 	return s.Parse(s.DefaultValue, ctx, data)
 }
 
@@ -100,7 +103,7 @@ func (s *StringProperty) Validate(resource *construct.Resource, value any, ctx k
 		return nil
 	}
 	if value == nil {
-		if s.Required {
+		if requiresValue(s.PropertyDetails, resource) {
 			return fmt.Errorf(knowledgebase.ErrRequiredProperty, s.Path, resource.ID)
 		}
 		return nil
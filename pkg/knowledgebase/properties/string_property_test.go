@@ -191,6 +191,15 @@ func Test_StringGetDefaultValue(t *testing.T) {
 			},
 			value: "test",
 		},
+		{
+			name: "conditional default renders empty",
+			property: &StringProperty{
+				SharedPropertyFields: SharedPropertyFields{
+					DefaultValue: "{{ if false }}test{{ end }}",
+				},
+			},
+			value: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -376,6 +385,7 @@ func Test_StringValidate(t *testing.T) {
 		testResources    []*construct.Resource
 		mockKBCalls      []mock.Call
 		value            any
+		imported         bool
 		expected         bool
 	}{
 		{
@@ -473,6 +483,39 @@ func Test_StringValidate(t *testing.T) {
 			value:    1,
 			expected: false,
 		},
+		{
+			name: "required string not set",
+			property: &StringProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{
+					Path:     "test",
+					Required: true,
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "required string not set on imported resource",
+			property: &StringProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{
+					Path:     "test",
+					Required: true,
+				},
+			},
+			imported: true,
+			expected: true,
+		},
+		{
+			name: "required deploy time string not set on imported resource",
+			property: &StringProperty{
+				PropertyDetails: knowledgebase.PropertyDetails{
+					Path:       "test",
+					Required:   true,
+					DeployTime: true,
+				},
+			},
+			imported: true,
+			expected: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -484,7 +527,7 @@ func Test_StringValidate(t *testing.T) {
 				}
 				tt.property.SanitizeTmpl = tmpl
 			}
-			resource := &construct.Resource{}
+			resource := &construct.Resource{Imported: tt.imported}
 			graph := construct.NewGraph()
 			for _, r := range tt.testResources {
 				graph.AddVertex(r)
@@ -26,8 +26,12 @@ type (
 
 		NoIac bool `json:"no_iac" yaml:"no_iac"`
 
+		IgnoreChanges []string `json:"ignore_changes" yaml:"ignore_changes"`
+
 		DeploymentPermissions knowledgebase.DeploymentPermissions `json:"deployment_permissions" yaml:"deployment_permissions"`
 
+		AccessPermissions knowledgebase.AccessPermissions `json:"access_permissions" yaml:"access_permissions"`
+
 		SanitizeNameTmpl string `yaml:"sanitize_name"`
 	}
 )
@@ -55,7 +59,9 @@ func (r *ResourceTemplate) Convert() (*knowledgebase.ResourceTemplate, error) {
 		DeleteContext:         r.DeleteContext,
 		Views:                 r.Views,
 		NoIac:                 r.NoIac,
+		IgnoreChanges:         r.IgnoreChanges,
 		DeploymentPermissions: r.DeploymentPermissions,
+		AccessPermissions:     r.AccessPermissions,
 		SanitizeNameTmpl:      sanitizeTmpl,
 	}, nil
 }
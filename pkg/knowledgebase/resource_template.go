@@ -48,9 +48,16 @@ type (
 		// NoIac defines if the resource should be ignored by the IaC engine
 		NoIac bool `json:"no_iac" yaml:"no_iac"`
 
+		// IgnoreChanges defines the IaC property paths which are managed out-of-band (for example, by an autoscaler)
+		// and should not be reverted by subsequent deployments
+		IgnoreChanges []string `json:"ignore_changes" yaml:"ignore_changes"`
+
 		// DeploymentPermissions defines the permissions that are required to deploy and tear down the resource
 		DeploymentPermissions DeploymentPermissions `json:"deployment_permissions" yaml:"deployment_permissions"`
 
+		// AccessPermissions defines the minimal permissions that are required to access the resource at runtime
+		AccessPermissions AccessPermissions `json:"access_permissions" yaml:"access_permissions"`
+
 		// SanitizeNameTmpl defines a template that is used to sanitize the name of the resource
 		SanitizeNameTmpl *SanitizeTmpl `yaml:"sanitize_name"`
 	}
@@ -64,6 +71,18 @@ type (
 		Update []string `json:"update" yaml:"update"`
 	}
 
+	AccessPermissions struct {
+		// Read defines the permissions that are required to read from the resource
+		Read []string `json:"read" yaml:"read"`
+		// Write defines the permissions that are required to write to the resource
+		Write []string `json:"write" yaml:"write"`
+		// Admin defines the permissions that are required to fully manage the resource
+		Admin []string `json:"admin" yaml:"admin"`
+	}
+
+	// AccessIntent is the kind of access a principal needs to a resource, used to look up its [AccessPermissions]
+	AccessIntent string
+
 	// PropertyDetails defines the common details of a property
 	PropertyDetails struct {
 		Name string `json:"name" yaml:"name"`
@@ -164,6 +183,9 @@ type (
 		RequiresNoDownstream bool `yaml:"requires_no_downstream" toml:"requires_no_downstream"`
 		// RequiresNoUpstreamOrDownstream is a boolean that tells us if deletion relies on there being no upstream or downstream resources
 		RequiresNoUpstreamOrDownstream bool `yaml:"requires_no_upstream_or_downstream" toml:"requires_no_upstream_or_downstream"`
+		// RequiresExplicitDelete is a boolean that tells us if the resource may only be deleted when explicitly requested,
+		// so it is never removed implicitly (for example, when pruning unreachable resources)
+		RequiresExplicitDelete bool `yaml:"requires_explicit_delete" toml:"requires_explicit_delete"`
 	}
 
 	Functionality string
@@ -365,6 +387,32 @@ func ReplacePath(p Property, original, replacement string) {
 	}
 }
 
+const (
+	AccessIntentRead  AccessIntent = "read"
+	AccessIntentWrite AccessIntent = "write"
+	AccessIntentAdmin AccessIntent = "admin"
+)
+
+// Actions returns the minimal set of actions required for the given intent. Each intent's actions are
+// self-contained, so "write" does not implicitly include the "read" actions.
+func (p AccessPermissions) Actions(intent AccessIntent) ([]string, error) {
+	var actions []string
+	switch intent {
+	case AccessIntentRead:
+		actions = p.Read
+	case AccessIntentWrite:
+		actions = p.Write
+	case AccessIntentAdmin:
+		actions = p.Admin
+	default:
+		return nil, fmt.Errorf("unknown access intent %q", intent)
+	}
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("no actions defined for access intent %q", intent)
+	}
+	return actions, nil
+}
+
 func (tmpl ResourceTemplate) LoopProperties(res *construct.Resource, addProp func(Property) error) error {
 	queue := []Properties{tmpl.Properties}
 	var props Properties
@@ -18,6 +18,8 @@ type LogOpts struct {
 	CategoryLogsDir string
 	Encoding        string
 	DefaultLevels   map[string]zapcore.Level
+	// Level, if set, overrides the minimum level derived from Verbose
+	Level *zapcore.Level
 }
 
 func (opts LogOpts) Encoder() zapcore.Encoder {
@@ -99,9 +101,12 @@ func (opts LogOpts) NewCore(w zapcore.WriteSyncer) zapcore.Core {
 	enc := opts.Encoder()
 
 	leveller := zap.NewAtomicLevel()
-	if opts.Verbose {
+	switch {
+	case opts.Level != nil:
+		leveller.SetLevel(*opts.Level)
+	case opts.Verbose:
 		leveller.SetLevel(zap.DebugLevel)
-	} else {
+	default:
 		leveller.SetLevel(zap.InfoLevel)
 	}
 
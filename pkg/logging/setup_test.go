@@ -0,0 +1,53 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogOpts_Level(t *testing.T) {
+	warn := zapcore.WarnLevel
+	tests := []struct {
+		name string
+		opts LogOpts
+		want []string
+	}{
+		{
+			name: "default",
+			opts: LogOpts{Encoding: "json"},
+			want: []string{"info", "warn"},
+		},
+		{
+			name: "verbose",
+			opts: LogOpts{Encoding: "json", Verbose: true},
+			want: []string{"debug", "info", "warn"},
+		},
+		{
+			name: "configured level filters debug",
+			opts: LogOpts{Encoding: "json", Verbose: true, Level: &warn},
+			want: []string{"warn"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			log := zap.New(tt.opts.NewCore(zapcore.AddSync(buf)))
+
+			log.Debug("debug message")
+			log.Info("info message")
+			log.Warn("warn message")
+
+			var got []string
+			for _, msg := range []string{"debug", "info", "warn"} {
+				if bytes.Contains(buf.Bytes(), []byte(msg+" message")) {
+					got = append(got, msg)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
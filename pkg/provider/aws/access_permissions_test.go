@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AccessActions(t *testing.T) {
+	tests := []struct {
+		name     string
+		intent   string
+		resource string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "s3 read",
+			intent:   "read",
+			resource: "aws:s3_bucket:bucket",
+			want:     []string{"s3:GetObject", "s3:ListBucket"},
+		},
+		{
+			name:     "dynamodb read",
+			intent:   "read",
+			resource: "aws:dynamodb_table:table",
+			want: []string{
+				"dynamodb:BatchGetItem",
+				"dynamodb:ConditionCheckItem",
+				"dynamodb:DescribeTable",
+				"dynamodb:GetItem",
+				"dynamodb:PartiQLSelect",
+				"dynamodb:Query",
+				"dynamodb:Scan",
+				"dynamodb:DescribeStream",
+				"dynamodb:GetRecords",
+				"dynamodb:GetShardIterator",
+				"dynamodb:ListStreams",
+			},
+		},
+		{
+			name:     "sqs write",
+			intent:   "write",
+			resource: "aws:sqs_queue:queue",
+			want:     []string{"sqs:SendMessage"},
+		},
+		{
+			name:     "sns write",
+			intent:   "write",
+			resource: "aws:sns_topic:topic",
+			want:     []string{"sns:Publish"},
+		},
+		{
+			name:     "unknown intent",
+			intent:   "delete",
+			resource: "aws:s3_bucket:bucket",
+			wantErr:  true,
+		},
+		{
+			name:     "no access permissions",
+			intent:   "read",
+			resource: "aws:vpc:vpc",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			kb, err := templates.NewKBFromTemplates()
+			require.NoError(err)
+
+			var id construct.ResourceId
+			require.NoError(id.Parse(tt.resource))
+
+			ctx := knowledgebase.DynamicValueContext{Graph: construct.NewGraph(), KnowledgeBase: kb}
+			got, err := ctx.AccessActions(tt.intent, id)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -28,11 +28,20 @@ var (
 		"aws:iam_role_policy_attachment",
 		"aws:api_deployment",
 		"aws:api_method",
+		"aws:api_method_settings",
 		"aws:api_resource",
 		"aws:ses_email_identity",
 		"aws:ecs_cluster_capacity_provider",
 		"aws:sns_topic_subscription",
 		"aws:cloudwatch_dashboard",
+		"aws:code_signing_config",
+		"aws:event_target",
+		"aws:app_autoscaling_policy",
+		"aws:route53_record",
+		"aws:acm_certificate_validation",
+		"aws:vpc_cidr_block_association",
+		"aws:http_api_integration",
+		"aws:cloudfront_origin_request_policy",
 	}
 )
 
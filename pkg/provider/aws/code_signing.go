@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+)
+
+var (
+	codeSigningConfigType = construct.ResourceId{Provider: "aws", Type: "code_signing_config"}
+
+	// signingProfileVersionArn matches the ARN of a signing profile version, for example
+	// arn:aws:signer:us-east-1:123456789012:/signing-profiles/my_profile/abcde12345
+	signingProfileVersionArn = regexp.MustCompile(`^arn:aws[\w-]*:signer:[\w-]+:\d{12}:/signing-profiles/\w+/\w+$`)
+)
+
+// AddAppCodeSigning adds a code signing config named name, which only trusts code signed by the signing profile
+// version, and attaches it to every Lambda function in the solution that doesn't already have its own code signing
+// config. The config is added through the solution's operational view, so the functions are configured to use it
+// when the solution is solved. Nothing is added if the solution has no Lambda functions.
+func AddAppCodeSigning(sol solution.Solution, name string, profileVersionArn string) error {
+	if !signingProfileVersionArn.MatchString(profileVersionArn) {
+		return fmt.Errorf("%q is not a signing profile version ARN", profileVersionArn)
+	}
+
+	var functions []construct.ResourceId
+	err := construct.WalkGraph(sol.DataflowGraph(), func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if !lambdaFunctionType.Matches(id) {
+			return nerr
+		}
+		signed, err := hasCodeSigningConfig(sol.DataflowGraph(), resource)
+		if err != nil {
+			return errors.Join(nerr, err)
+		}
+		if !signed {
+			functions = append(functions, id)
+		}
+		return nerr
+	})
+	if err != nil || len(functions) == 0 {
+		return err
+	}
+
+	config := &construct.Resource{
+		ID: construct.ResourceId{Provider: "aws", Type: "code_signing_config", Name: name},
+		Properties: construct.Properties{
+			"SigningProfileVersionArns": []any{profileVersionArn},
+		},
+	}
+	if err := sol.OperationalView().AddVertex(config); err != nil && !errors.Is(err, graph.ErrVertexAlreadyExists) {
+		return fmt.Errorf("could not add %s: %w", config.ID, err)
+	}
+	var errs error
+	for _, fn := range functions {
+		errs = errors.Join(errs, sol.OperationalView().AddEdge(fn, config.ID))
+	}
+	return errs
+}
+
+// hasCodeSigningConfig returns whether the function sets its code signing config, either by property or by an edge
+// to the config which is yet to be made operational.
+func hasCodeSigningConfig(g construct.Graph, fn *construct.Resource) (bool, error) {
+	if _, ok := fn.Properties["CodeSigningConfig"].(construct.ResourceId); ok {
+		return true, nil
+	}
+	deps, err := construct.DirectDownstreamDependencies(g, fn.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, dep := range deps {
+		if codeSigningConfigType.Matches(dep) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
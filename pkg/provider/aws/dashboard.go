@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+)
+
+var (
+	lambdaFunctionType = construct.ResourceId{Provider: "aws", Type: "lambda_function"}
+	rdsInstanceType    = construct.ResourceId{Provider: "aws", Type: "rds_instance"}
+	regionType         = construct.ResourceId{Provider: "aws", Type: "region"}
+)
+
+const (
+	// dashboardWidgetWidth is the width of each widget, fitting two per row of the 24-column dashboard grid.
+	// Widgets are not given a position so CloudWatch lays them out in order.
+	dashboardWidgetWidth = 12
+	// dashboardWidgetHeight is the height of each widget
+	dashboardWidgetHeight = 6
+	// dashboardPeriod is the period, in seconds, that the widgets' metrics are aggregated over
+	dashboardPeriod = 300
+)
+
+// Dashboard returns a CloudWatch dashboard named name, with a widget graphing the invocations, errors and duration of
+// each Lambda function in the graph and one graphing the CPU, connections and free storage of each RDS instance.
+// Widgets are ordered by resource ID so the dashboard is stable between runs. The dashboard is returned along with the
+// region its metrics are in if the graph has none, and nothing is returned if the graph has no Lambda functions or RDS
+// instances. The returned resources are not added to the graph.
+func Dashboard(g construct.Graph, name string) ([]*construct.Resource, error) {
+	var functions, instances []construct.ResourceId
+	var region construct.ResourceId
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		switch {
+		case lambdaFunctionType.Matches(id):
+			functions = append(functions, id)
+		case rdsInstanceType.Matches(id):
+			instances = append(instances, id)
+		case regionType.Matches(id) && region.IsZero():
+			region = id
+		}
+		return nerr
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(functions) == 0 && len(instances) == 0 {
+		return nil, nil
+	}
+	sortIds := func(ids []construct.ResourceId) {
+		sort.Slice(ids, func(i, j int) bool { return construct.ResourceIdLess(ids[i], ids[j]) })
+	}
+	sortIds(functions)
+	sortIds(instances)
+
+	var resources []*construct.Resource
+	if region.IsZero() {
+		region = construct.ResourceId{Provider: "aws", Type: "region", Name: "region-0"}
+		resources = append(resources, &construct.Resource{ID: region, Properties: make(construct.Properties)})
+	}
+	regionName := construct.PropertyRef{Resource: region, Property: "Name"}
+
+	var widgets []any
+	addWidget := func(title string, stat string, metrics []any) {
+		widgets = append(widgets, map[string]any{
+			"Type":   "metric",
+			"Width":  dashboardWidgetWidth,
+			"Height": dashboardWidgetHeight,
+			"Properties": map[string]any{
+				"Title":   title,
+				"Region":  regionName,
+				"View":    "timeSeries",
+				"Stat":    stat,
+				"Period":  dashboardPeriod,
+				"Metrics": metrics,
+			},
+		})
+	}
+	for _, fn := range functions {
+		functionName := construct.PropertyRef{Resource: fn, Property: "FunctionName"}
+		addWidget(fn.Name, "Sum", []any{
+			[]any{"AWS/Lambda", "Invocations", "FunctionName", functionName},
+			[]any{"AWS/Lambda", "Errors", "FunctionName", functionName},
+			[]any{"AWS/Lambda", "Duration", "FunctionName", functionName, map[string]any{"Stat": "Average"}},
+		})
+	}
+	for _, db := range instances {
+		identifier := construct.PropertyRef{Resource: db, Property: "Identifier"}
+		addWidget(db.Name, "Average", []any{
+			[]any{"AWS/RDS", "CPUUtilization", "DBInstanceIdentifier", identifier},
+			[]any{"AWS/RDS", "DatabaseConnections", "DBInstanceIdentifier", identifier},
+			[]any{"AWS/RDS", "FreeStorageSpace", "DBInstanceIdentifier", identifier},
+		})
+	}
+
+	dashboard := &construct.Resource{
+		ID: construct.ResourceId{Provider: "aws", Type: "cloudwatch_dashboard", Name: name},
+		Properties: construct.Properties{
+			"DashboardBody": map[string]any{"Widgets": widgets},
+		},
+	}
+	return append(resources, dashboard), nil
+}
+
+// AddDashboard adds the [Dashboard] of the solution's resources through its operational view, so the engine
+// configures it when the solution is next solved. The dashboard is deployed after the resources it graphs.
+func AddDashboard(sol solution.Solution, name string) error {
+	resources, err := Dashboard(sol.DataflowGraph(), name)
+	if err != nil {
+		return err
+	}
+	for _, res := range resources {
+		err := sol.OperationalView().AddVertex(res)
+		if err != nil && !errors.Is(err, graph.ErrVertexAlreadyExists) {
+			return fmt.Errorf("could not add %s: %w", res.ID, err)
+		}
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	// The widgets only refer to the resources they graph by property, so add the deployment order explicitly
+	dashboard := resources[len(resources)-1]
+	return solution.AddDeploymentDependenciesFromVal(sol, dashboard, dashboard.Properties["DashboardBody"])
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dashboard(t *testing.T) {
+	tests := []struct {
+		name        string
+		graph       []any
+		wantTitles  []string
+		wantMetrics []string
+		wantRegion  string
+	}{
+		{
+			name:       "no lambdas or databases",
+			graph:      []any{"aws:s3_bucket:bucket"},
+			wantTitles: nil,
+		},
+		{
+			name: "widget per lambda",
+			graph: []any{
+				"aws:lambda_function:b",
+				"aws:lambda_function:a",
+				"aws:s3_bucket:bucket",
+			},
+			wantTitles:  []string{"a", "b"},
+			wantMetrics: []string{"Invocations", "Errors", "Duration"},
+			wantRegion:  "aws:region:region-0",
+		},
+		{
+			name: "rds instances after lambdas",
+			graph: []any{
+				"aws:rds_instance:db",
+				"aws:lambda_function:a",
+				"aws:region:existing",
+			},
+			wantTitles:  []string{"a", "db"},
+			wantMetrics: []string{"Invocations", "Errors", "Duration"},
+			wantRegion:  "aws:region:existing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+			resources, err := Dashboard(g, "app-dashboard")
+			require.NoError(err)
+			if tt.wantTitles == nil {
+				assert.Empty(resources)
+				return
+			}
+			// the dashboard is last, after the region if the graph has none
+			dashboard := resources[len(resources)-1]
+			assert.Equal(graphtest.ParseId(t, "aws:cloudwatch_dashboard:app-dashboard"), dashboard.ID)
+			for _, res := range resources[:len(resources)-1] {
+				require.NoError(g.AddVertex(res))
+			}
+
+			body, ok := dashboard.Properties["DashboardBody"].(map[string]any)
+			require.True(ok)
+			widgets, ok := body["Widgets"].([]any)
+			require.True(ok)
+			require.Len(widgets, len(tt.wantTitles))
+
+			for i, title := range tt.wantTitles {
+				props := widgets[i].(map[string]any)["Properties"].(map[string]any)
+				assert.Equal(title, props["Title"])
+				assert.Equal(
+					construct.PropertyRef{Resource: graphtest.ParseId(t, tt.wantRegion), Property: "Name"},
+					props["Region"],
+				)
+			}
+
+			// The first widget is always a lambda's, with a metric for each of the function's statistics
+			props := widgets[0].(map[string]any)["Properties"].(map[string]any)
+			metrics := props["Metrics"].([]any)
+			require.Len(metrics, len(tt.wantMetrics))
+			fn := graphtest.ParseId(t, "aws:lambda_function:"+tt.wantTitles[0])
+			for i, name := range tt.wantMetrics {
+				metric := metrics[i].([]any)
+				assert.Equal("AWS/Lambda", metric[0])
+				assert.Equal(name, metric[1])
+				assert.Equal(construct.PropertyRef{Resource: fn, Property: "FunctionName"}, metric[3])
+			}
+
+			_, err = g.Vertex(graphtest.ParseId(t, tt.wantRegion))
+			assert.NoError(err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
+var (
+	rateExpression = regexp.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`)
+	cronExpression = regexp.MustCompile(`^cron\((.*)\)$`)
+)
+
+// CheckEventRuleSchedules returns an error for each EventBridge rule whose ScheduleExpression EventBridge would
+// reject when the rule is deployed.
+func CheckEventRuleSchedules(g construct.Graph) error {
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if id.Provider != "aws" || id.Type != "event_rule" {
+			return nerr
+		}
+		expr, _ := resource.Properties["ScheduleExpression"].(string)
+		if expr == "" {
+			return nerr
+		}
+		if err := validateScheduleExpression(expr); err != nil {
+			return errors.Join(nerr, fmt.Errorf("%s has an invalid ScheduleExpression %q: %w", id, expr, err))
+		}
+		return nerr
+	})
+}
+
+// validateScheduleExpression checks that the expression is a valid `rate(value unit)` or
+// `cron(minutes hours day-of-month month day-of-week year)` expression.
+func validateScheduleExpression(expr string) error {
+	if strings.HasPrefix(expr, "rate(") {
+		m := rateExpression.FindStringSubmatch(expr)
+		if m == nil {
+			return errors.New("rate expressions must be of the form rate(value unit), such as rate(5 minutes)")
+		}
+		value, err := strconv.Atoi(m[1])
+		if err != nil {
+			return err
+		}
+		if value < 1 {
+			return errors.New("rate value must be a positive number")
+		}
+		if singular := !strings.HasSuffix(m[2], "s"); singular != (value == 1) {
+			return fmt.Errorf("rate unit must be singular for a value of 1 and plural otherwise, got %q", m[1]+" "+m[2])
+		}
+		return nil
+	}
+
+	m := cronExpression.FindStringSubmatch(expr)
+	if m == nil {
+		return errors.New("must be a rate(...) or cron(...) expression")
+	}
+	fields := strings.Fields(m[1])
+	if len(fields) != 6 {
+		return fmt.Errorf(
+			"cron expressions must have 6 fields (minutes hours day-of-month month day-of-week year), got %d",
+			len(fields),
+		)
+	}
+	dayOfMonth, dayOfWeek := fields[2], fields[4]
+	if (dayOfMonth == "?") == (dayOfWeek == "?") {
+		return errors.New("exactly one of the day-of-month or day-of-week fields must be '?'")
+	}
+	return nil
+}
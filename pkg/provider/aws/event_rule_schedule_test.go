@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckEventRuleSchedules(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		wantErr  string
+	}{
+		{
+			name: "event pattern only",
+		},
+		{
+			name:     "rate",
+			schedule: "rate(5 minutes)",
+		},
+		{
+			name:     "singular rate",
+			schedule: "rate(1 hour)",
+		},
+		{
+			name:     "cron",
+			schedule: "cron(0 2 * * ? *)",
+		},
+		{
+			name:     "cron on weekdays",
+			schedule: "cron(0/15 9-17 ? * MON-FRI *)",
+		},
+		{
+			name:     "plural unit for a rate of 1",
+			schedule: "rate(1 hours)",
+			wantErr:  "rate unit must be singular for a value of 1 and plural otherwise",
+		},
+		{
+			name:     "zero rate",
+			schedule: "rate(0 minutes)",
+			wantErr:  "rate value must be a positive number",
+		},
+		{
+			name:     "unknown rate unit",
+			schedule: "rate(2 weeks)",
+			wantErr:  "rate expressions must be of the form rate(value unit)",
+		},
+		{
+			name:     "unix cron",
+			schedule: "cron(0 2 * * *)",
+			wantErr:  "cron expressions must have 6 fields",
+		},
+		{
+			name:     "cron without a '?' day",
+			schedule: "cron(0 2 * * * *)",
+			wantErr:  "exactly one of the day-of-month or day-of-week fields must be '?'",
+		},
+		{
+			name:     "not an expression",
+			schedule: "every day",
+			wantErr:  `aws:event_rule:nightly has an invalid ScheduleExpression "every day": must be a rate(...) or cron(...) expression`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &construct.Resource{
+				ID:         construct.ResourceId{Provider: "aws", Type: "event_rule", Name: "nightly"},
+				Properties: construct.Properties{},
+			}
+			if tt.schedule != "" {
+				rule.Properties["ScheduleExpression"] = tt.schedule
+			}
+			g := graphtest.MakeGraph(t, construct.NewGraph(), rule)
+
+			err := CheckEventRuleSchedules(g)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
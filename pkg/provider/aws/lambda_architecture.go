@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// lambdaPlatforms maps a Lambda function's architecture to the platform its container image must be built for
+var lambdaPlatforms = map[string]string{
+	"x86_64": "linux/amd64",
+	"arm64":  "linux/arm64",
+}
+
+// CheckLambdaArchitectures returns an error for each Lambda function whose image is built for a different platform
+// than the function's architecture, such as an arm64 function whose image is pinned to an x86-only base image.
+// Lambda would otherwise only fail when the function is invoked.
+func CheckLambdaArchitectures(g construct.Graph) error {
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if id.Provider != "aws" || id.Type != "lambda_function" {
+			return nerr
+		}
+		arch, _ := resource.Properties["Architecture"].(string)
+		if arch == "" {
+			arch = "x86_64"
+		}
+		want, ok := lambdaPlatforms[arch]
+		if !ok {
+			// unknown architectures are rejected by the property's allowed values
+			return nerr
+		}
+		image, ok := resource.Properties["Image"].(construct.PropertyRef)
+		if !ok || image.Resource.QualifiedTypeName() != "aws:ecr_image" {
+			return nerr
+		}
+		imageRes, err := g.Vertex(image.Resource)
+		if err != nil {
+			return errors.Join(nerr, fmt.Errorf("could not get image for %s: %w", id, err))
+		}
+		platform, _ := imageRes.Properties["Platform"].(string)
+		if platform != "" && platform != want {
+			return errors.Join(nerr, fmt.Errorf(
+				"%s uses the %s architecture but its image %s is built for %s: build the image for %s or change the function's Architecture",
+				id, arch, image.Resource, platform, want,
+			))
+		}
+		return nerr
+	})
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckLambdaArchitectures(t *testing.T) {
+	tests := []struct {
+		name         string
+		architecture string
+		platform     string
+		wantErr      string
+	}{
+		{
+			name:     "default architecture",
+			platform: "linux/amd64",
+		},
+		{
+			name:         "arm64 image",
+			architecture: "arm64",
+			platform:     "linux/arm64",
+		},
+		{
+			name:         "arm64 function with x86 image",
+			architecture: "arm64",
+			platform:     "linux/amd64",
+			wantErr:      "aws:lambda_function:fn uses the arm64 architecture but its image aws:ecr_image:fn-image is built for linux/amd64",
+		},
+		{
+			name:     "x86 function with arm64 image",
+			platform: "linux/arm64",
+			wantErr:  "aws:lambda_function:fn uses the x86_64 architecture but its image aws:ecr_image:fn-image is built for linux/arm64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image := construct.ResourceId{Provider: "aws", Type: "ecr_image", Name: "fn-image"}
+			fn := &construct.Resource{
+				ID: construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: "fn"},
+				Properties: construct.Properties{
+					"Image": construct.PropertyRef{Resource: image, Property: "ImageName"},
+				},
+			}
+			if tt.architecture != "" {
+				fn.Properties["Architecture"] = tt.architecture
+			}
+			g := graphtest.MakeGraph(t, construct.NewGraph(),
+				fn,
+				&construct.Resource{ID: image, Properties: construct.Properties{"Platform": tt.platform}},
+				"aws:lambda_function:fn -> aws:ecr_image:fn-image",
+			)
+
+			err := CheckLambdaArchitectures(g)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// maxLambdaLayers is the number of layers a function can use
+const maxLambdaLayers = 5
+
+// ApplyAppLayers adds the app-wide layers to every Lambda function in the graph. The app-wide layers come before
+// each function's own layers, so a function's layers can override files from the common ones. Layers a function
+// already uses are not added twice.
+func ApplyAppLayers(g construct.Graph, layers []string) error {
+	if len(layers) == 0 {
+		return nil
+	}
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if id.Provider != "aws" || id.Type != "lambda_function" {
+			return nerr
+		}
+		merged := mergeLayers(layers, toStrings(resource.Properties["Layers"]))
+		if len(merged) > maxLambdaLayers {
+			return errors.Join(nerr, fmt.Errorf(
+				"%s would use %d layers, more than the maximum of %d", id, len(merged), maxLambdaLayers,
+			))
+		}
+		value := make([]any, len(merged))
+		for i, layer := range merged {
+			value[i] = layer
+		}
+		return errors.Join(nerr, resource.SetProperty("Layers", value))
+	})
+}
+
+// mergeLayers concatenates the layer lists, keeping only the first occurrence of each layer.
+func mergeLayers(lists ...[]string) []string {
+	seen := make(map[string]struct{})
+	var merged []string
+	for _, list := range lists {
+		for _, layer := range list {
+			if _, ok := seen[layer]; ok {
+				continue
+			}
+			seen[layer] = struct{}{}
+			merged = append(merged, layer)
+		}
+	}
+	return merged
+}
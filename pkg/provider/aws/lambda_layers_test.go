@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyAppLayers(t *testing.T) {
+	const (
+		observability = "arn:aws:lambda:us-east-1:123456789012:layer:observability:3"
+		shared        = "arn:aws:lambda:us-east-1:123456789012:layer:shared:1"
+	)
+	tests := []struct {
+		name      string
+		unitA     []any
+		unitB     []any
+		appLayers []string
+		wantA     []any
+		wantB     []any
+		wantErr   bool
+	}{
+		{
+			name:      "app layer added to every function",
+			appLayers: []string{observability},
+			wantA:     []any{observability},
+			wantB:     []any{observability},
+		},
+		{
+			name:      "merged with per-unit layers",
+			unitA:     []any{shared},
+			appLayers: []string{observability},
+			wantA:     []any{observability, shared},
+			wantB:     []any{observability},
+		},
+		{
+			name:      "duplicate layers removed",
+			unitA:     []any{observability, shared},
+			unitB:     []any{observability},
+			appLayers: []string{observability},
+			wantA:     []any{observability, shared},
+			wantB:     []any{observability},
+		},
+		{
+			name:      "too many layers",
+			unitA:     []any{"a", "b", "c", "d", "e"},
+			appLayers: []string{observability},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			makeLambda := func(name string, layers []any) *construct.Resource {
+				res := &construct.Resource{
+					ID:         construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: name},
+					Properties: construct.Properties{},
+				}
+				if layers != nil {
+					res.Properties["Layers"] = layers
+				}
+				return res
+			}
+			g := graphtest.MakeGraph(t, construct.NewGraph(),
+				makeLambda("a", tt.unitA),
+				makeLambda("b", tt.unitB),
+				"aws:s3_bucket:bucket",
+			)
+
+			err := ApplyAppLayers(g, tt.appLayers)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			for name, want := range map[string][]any{"a": tt.wantA, "b": tt.wantB} {
+				res, err := g.Vertex(construct.ResourceId{Provider: "aws", Type: "lambda_function", Name: name})
+				require.NoError(err)
+				assert.Equal(want, res.Properties["Layers"], name)
+			}
+			bucket, err := g.Vertex(construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"})
+			require.NoError(err)
+			assert.NotContains(bucket.Properties, "Layers")
+		})
+	}
+}
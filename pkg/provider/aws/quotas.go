@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// DefaultQuotas are the default AWS account limits, per region, for the resource types which most commonly cause
+// deployments to fail. Accounts can have these raised, so they should be overridden to match the account.
+// NAT gateways are limited per availability zone, so their region-wide count only indicates a likely problem.
+var DefaultQuotas = map[string]int{
+	"aws:vpc":              5,
+	"aws:elastic_ip":       5,
+	"aws:nat_gateway":      5,
+	"aws:internet_gateway": 5,
+	"aws:load_balancer":    50,
+	"aws:target_group":     3000,
+}
+
+// QuotaViolation is a resource type whose count in the graph exceeds its quota.
+type QuotaViolation struct {
+	Type  string
+	Count int
+	Limit int
+}
+
+func (v QuotaViolation) String() string {
+	return fmt.Sprintf("%d %s resources exceed the account limit of %d", v.Count, v.Type, v.Limit)
+}
+
+// CheckQuotas counts the resources in the graph by type and returns the types which exceed their quota, sorted by
+// type. Types without a quota are not limited.
+func CheckQuotas(g construct.Graph, quotas map[string]int) ([]QuotaViolation, error) {
+	counts := make(map[string]int)
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		counts[id.QualifiedTypeName()]++
+		return nerr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []QuotaViolation
+	for qualifiedType, count := range counts {
+		limit, ok := quotas[qualifiedType]
+		if ok && count > limit {
+			violations = append(violations, QuotaViolation{Type: qualifiedType, Count: count, Limit: limit})
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Type < violations[j].Type
+	})
+	return violations, nil
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckQuotas(t *testing.T) {
+	elasticIps := func(n int) []any {
+		ids := make([]any, n)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("aws:elastic_ip:eip-%d", i)
+		}
+		return ids
+	}
+	tests := []struct {
+		name   string
+		graph  []any
+		quotas map[string]int
+		want   []QuotaViolation
+	}{
+		{
+			name:   "within limit",
+			graph:  elasticIps(5),
+			quotas: DefaultQuotas,
+		},
+		{
+			name:   "elastic ips exceed limit",
+			graph:  append(elasticIps(6), "aws:vpc:vpc"),
+			quotas: DefaultQuotas,
+			want:   []QuotaViolation{{Type: "aws:elastic_ip", Count: 6, Limit: 5}},
+		},
+		{
+			name:   "raised limit",
+			graph:  elasticIps(6),
+			quotas: map[string]int{"aws:elastic_ip": 10},
+		},
+		{
+			name:   "types without a quota are not limited",
+			graph:  elasticIps(6),
+			quotas: map[string]int{"aws:vpc": 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+
+			got, err := CheckQuotas(g, tt.quotas)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// RouteFunction configures the Lambda function which serves an API route. Routes are matched against the
+// `Route` of the API integrations in the graph.
+type RouteFunction struct {
+	Route      string `yaml:"route"`
+	Timeout    int    `yaml:"timeout,omitempty"`
+	MemorySize int    `yaml:"memory_size,omitempty"`
+}
+
+var (
+	apiIntegrationId   = construct.ResourceId{Provider: "aws", Type: "api_integration"}
+	lambdaFunctionId   = construct.ResourceId{Provider: "aws", Type: "lambda_function"}
+	lambdaPermissionId = construct.ResourceId{Provider: "aws", Type: "lambda_permission"}
+)
+
+// SplitRouteFunctions gives each configured route its own Lambda function so that it can be sized independently
+// of the other routes the function serves. The new function is a copy of the original, including its downstream
+// dependencies (so it has the same access), and the route's integration is rewired to it. Resources unique to the
+// original function (such as its execution role) are not shared with the copy, so the engine creates its own. A
+// function which only serves the configured route is configured in place.
+//
+// An integration serves a function through an edge to it, either in the graph or as an edge constraint, or through
+// the lambda permission between them in an already solved graph. A split off function's permission is removed so
+// that the engine creates one for the new function.
+//
+// It is meant to be applied to the input graph and constraints so the engine makes the new functions operational.
+func SplitRouteFunctions(
+	g construct.Graph,
+	c *constraints.Constraints,
+	kb knowledgebase.TemplateKB,
+	routes []RouteFunction,
+) error {
+	var errs error
+	for _, route := range routes {
+		errs = errors.Join(errs, splitRouteFunction(g, c, kb, route))
+	}
+	return errs
+}
+
+func splitRouteFunction(g construct.Graph, c *constraints.Constraints, kb knowledgebase.TemplateKB, route RouteFunction) error {
+	integrations, err := routeIntegrations(g, route.Route)
+	if err != nil {
+		return err
+	}
+	if len(integrations) == 0 {
+		return fmt.Errorf("no API integration found for route %q", route.Route)
+	}
+
+	var errs error
+	for _, integration := range integrations {
+		functions, err := servedFunctions(g, c, integration)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		for _, fnId := range functions {
+			fn, err := g.Vertex(fnId)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			servesOthers, err := servesOtherIntegrations(g, c, fnId, integration)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			if servesOthers {
+				fn, err = splitFunction(g, c, kb, fn, integration, route.Route)
+				if err != nil {
+					errs = errors.Join(errs, fmt.Errorf("could not split route %q from %s: %w", route.Route, fnId, err))
+					continue
+				}
+			}
+			if route.Timeout > 0 {
+				errs = errors.Join(errs, fn.SetProperty("Timeout", route.Timeout))
+			}
+			if route.MemorySize > 0 {
+				errs = errors.Join(errs, fn.SetProperty("MemorySize", route.MemorySize))
+			}
+		}
+	}
+	return errs
+}
+
+func routeIntegrations(g construct.Graph, route string) ([]construct.ResourceId, error) {
+	var ids []construct.ResourceId
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if apiIntegrationId.Matches(id) && resource.Properties["Route"] == route {
+			ids = append(ids, id)
+		}
+		return nerr
+	})
+	return ids, err
+}
+
+// servedFunctions returns the Lambda functions the integration serves, sorted by ID.
+func servedFunctions(g construct.Graph, c *constraints.Constraints, integration construct.ResourceId) ([]construct.ResourceId, error) {
+	functions := make(set.Set[construct.ResourceId])
+	downstream, err := construct.DirectDownstreamDependencies(g, integration)
+	if err != nil {
+		return nil, err
+	}
+	for _, dep := range downstream {
+		switch {
+		case lambdaFunctionId.Matches(dep):
+			functions.Add(dep)
+		case lambdaPermissionId.Matches(dep):
+			permitted, err := construct.DirectDownstreamDependencies(g, dep)
+			if err != nil {
+				return nil, err
+			}
+			for _, fn := range permitted {
+				if lambdaFunctionId.Matches(fn) {
+					functions.Add(fn)
+				}
+			}
+		}
+	}
+	for _, edge := range c.Edges {
+		if addsEdge(edge) && edge.Target.Source == integration && lambdaFunctionId.Matches(edge.Target.Target) {
+			functions.Add(edge.Target.Target)
+		}
+	}
+	ids := functions.ToSlice()
+	sort.Sort(construct.SortedIds(ids))
+	return ids, nil
+}
+
+func servesOtherIntegrations(
+	g construct.Graph,
+	c *constraints.Constraints,
+	fn, integration construct.ResourceId,
+) (bool, error) {
+	var others []construct.ResourceId
+	err := construct.WalkGraph(g, func(id construct.ResourceId, _ *construct.Resource, nerr error) error {
+		if apiIntegrationId.Matches(id) && id != integration {
+			others = append(others, id)
+		}
+		return nerr
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, other := range others {
+		functions, err := servedFunctions(g, c, other)
+		if err != nil {
+			return false, err
+		}
+		if slices.Contains(functions, fn) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func addsEdge(edge constraints.EdgeConstraint) bool {
+	return edge.Operator == constraints.AddConstraintOperator || edge.Operator == constraints.MustExistConstraintOperator
+}
+
+// splitFunction copies the function for the integration's route and moves the integration over to the copy.
+func splitFunction(
+	g construct.Graph,
+	c *constraints.Constraints,
+	kb knowledgebase.TemplateKB,
+	fn *construct.Resource,
+	integration construct.ResourceId,
+	route string,
+) (*construct.Resource, error) {
+	rt, err := kb.GetResourceTemplate(fn.ID)
+	if err != nil {
+		return nil, err
+	}
+	newFn := &construct.Resource{
+		ID:         fn.ID,
+		Properties: fn.Properties.Clone(),
+	}
+	// Unique properties are resources created for each function, so leave them (and the edges to them) for the
+	// engine to create for the copy.
+	unique := make(set.Set[construct.ResourceId])
+	for name, value := range fn.Properties {
+		prop := rt.GetProperty(name)
+		if prop == nil {
+			continue
+		}
+		if rule := prop.Details().OperationalRule; rule != nil && rule.Step.Unique {
+			delete(newFn.Properties, name)
+			unique.Add(referencedResources(value)...)
+		}
+	}
+	newFn.ID.Name = fmt.Sprintf("%s-%s", fn.ID.Name, routeName(route))
+	switch err := g.AddVertex(newFn); {
+	case errors.Is(err, graph.ErrVertexAlreadyExists):
+		// The route was already split off for another integration (such as another API with the same route)
+		newFn, err = g.Vertex(newFn.ID)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	downstream, err := construct.DirectDownstreamDependencies(g, fn.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, dep := range downstream {
+		if unique.Contains(dep) {
+			continue
+		}
+		if et := kb.GetEdgeTemplate(fn.ID, dep); et != nil && et.Unique.Target {
+			continue
+		}
+		if err := g.AddEdge(newFn.ID, dep); err != nil && !errors.Is(err, graph.ErrEdgeAlreadyExists) {
+			return nil, err
+		}
+	}
+	for _, edge := range c.Edges {
+		if addsEdge(edge) && edge.Target.Source == fn.ID && !unique.Contains(edge.Target.Target) {
+			edge.Target.Source = newFn.ID
+			if !slices.Contains(c.Edges, edge) {
+				c.Edges = append(c.Edges, edge)
+			}
+		}
+	}
+
+	if err := moveIntegration(g, c, integration, fn.ID, newFn.ID); err != nil {
+		return nil, err
+	}
+	integrationRes, err := g.Vertex(integration)
+	if err != nil {
+		return nil, err
+	}
+	if integrationRes.Properties["Target"] == fn.ID {
+		integrationRes.Properties["Target"] = newFn.ID
+	}
+	return newFn, nil
+}
+
+// moveIntegration rewires the integration from the function to the new function.
+func moveIntegration(g construct.Graph, c *constraints.Constraints, integration, fn, newFn construct.ResourceId) error {
+	for i, edge := range c.Edges {
+		if addsEdge(edge) && edge.Target == (constraints.Edge{Source: integration, Target: fn}) {
+			c.Edges[i].Target.Target = newFn
+		}
+	}
+
+	downstream, err := construct.DirectDownstreamDependencies(g, integration)
+	if err != nil {
+		return err
+	}
+	for _, dep := range downstream {
+		switch {
+		case dep == fn:
+			if err := g.RemoveEdge(integration, fn); err != nil {
+				return err
+			}
+			if err := g.AddEdge(integration, newFn); err != nil {
+				return err
+			}
+
+		case lambdaPermissionId.Matches(dep):
+			if _, err := g.Edge(dep, fn); err != nil {
+				continue
+			}
+			// The permission only allows invoking the original function, so replace it with an edge constraint for
+			// the engine to connect (and permit) the new function.
+			if err := construct.RemoveResource(g, dep); err != nil {
+				return err
+			}
+			edge := constraints.EdgeConstraint{
+				Operator: constraints.MustExistConstraintOperator,
+				Target:   constraints.Edge{Source: integration, Target: newFn},
+			}
+			if !slices.Contains(c.Edges, edge) {
+				c.Edges = append(c.Edges, edge)
+			}
+		}
+	}
+	return nil
+}
+
+// referencedResources returns the resources referenced by the property value.
+func referencedResources(value any) []construct.ResourceId {
+	switch value := value.(type) {
+	case construct.ResourceId:
+		return []construct.ResourceId{value}
+	case construct.PropertyRef:
+		return []construct.ResourceId{value.Resource}
+	case []any:
+		var ids []construct.ResourceId
+		for _, v := range value {
+			ids = append(ids, referencedResources(v)...)
+		}
+		return ids
+	}
+	return nil
+}
+
+// routeName turns the route into a string usable in a resource name, for example `/reports/{id}` becomes
+// `reports-id`.
+func routeName(route string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '-'
+		}
+	}, route)
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return "root"
+	}
+	return name
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/engine/constraints"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SplitRouteFunctions(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	api := graphtest.ParseId(t, "aws:rest_api:api")
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	table := graphtest.ParseId(t, "aws:dynamodb_table:table")
+	role := graphtest.ParseId(t, "aws:iam_role:fn-ExecutionRole")
+	reports := graphtest.ParseId(t, "aws:api_integration:api:reports")
+	search := graphtest.ParseId(t, "aws:api_integration:api:search")
+	items := graphtest.ParseId(t, "aws:api_integration:api:items")
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: fn, Properties: construct.Properties{
+			"Handler":              "index.handler",
+			"EnvironmentVariables": map[string]any{"TABLE": "table"},
+			"ExecutionRole":        role,
+		}},
+		&construct.Resource{ID: reports, Properties: construct.Properties{"Route": "/reports/{id}", "Target": fn}},
+		&construct.Resource{ID: search, Properties: construct.Properties{"Route": "/search"}},
+		&construct.Resource{ID: items, Properties: construct.Properties{"Route": "/items"}},
+		"aws:rest_api:api -> aws:api_integration:api:reports",
+		"aws:rest_api:api -> aws:api_integration:api:search",
+		"aws:rest_api:api -> aws:api_integration:api:items",
+		"aws:api_integration:api:reports -> aws:lambda_function:fn",
+		"aws:api_integration:api:search -> aws:lambda_function:fn",
+		"aws:api_integration:api:items -> aws:lambda_function:fn",
+		"aws:lambda_function:fn -> aws:dynamodb_table:table",
+		"aws:lambda_function:fn -> aws:iam_role:fn-ExecutionRole",
+	)
+
+	require.NoError(SplitRouteFunctions(g, &constraints.Constraints{}, kb, []RouteFunction{
+		{Route: "/reports/{id}", Timeout: 900, MemorySize: 3008},
+		{Route: "/search", Timeout: 10, MemorySize: 256},
+	}))
+
+	reportsFn := graphtest.ParseId(t, "aws:lambda_function:fn-reports-id")
+	searchFn := graphtest.ParseId(t, "aws:lambda_function:fn-search")
+	for _, tt := range []struct {
+		integration construct.ResourceId
+		fn          construct.ResourceId
+		timeout     int
+		memory      int
+	}{
+		{integration: reports, fn: reportsFn, timeout: 900, memory: 3008},
+		{integration: search, fn: searchFn, timeout: 10, memory: 256},
+	} {
+		res, err := g.Vertex(tt.fn)
+		require.NoError(err)
+		assert.Equal(tt.timeout, res.Properties["Timeout"], tt.fn)
+		assert.Equal(tt.memory, res.Properties["MemorySize"], tt.fn)
+		assert.Equal("index.handler", res.Properties["Handler"], tt.fn)
+		assert.Equal(map[string]any{"TABLE": "table"}, res.Properties["EnvironmentVariables"], tt.fn)
+		require.NoError(res.SetProperty("EnvironmentVariables.ROUTE", tt.fn.Name))
+
+		_, err = g.Edge(tt.integration, tt.fn)
+		assert.NoError(err, "%s should be served by %s", tt.integration, tt.fn)
+		_, err = g.Edge(tt.integration, fn)
+		assert.Error(err, "%s should no longer be served by %s", tt.integration, fn)
+		_, err = g.Edge(tt.fn, table)
+		assert.NoError(err, "%s should keep the original function's dependencies", tt.fn)
+
+		assert.NotContains(res.Properties, "ExecutionRole", "%s should get its own execution role", tt.fn)
+		_, err = g.Edge(tt.fn, role)
+		assert.Error(err, "%s should not share the original function's execution role", tt.fn)
+	}
+
+	reportsIntegration, err := g.Vertex(reports)
+	require.NoError(err)
+	assert.Equal(reportsFn, reportsIntegration.Properties["Target"])
+
+	// The remaining route keeps the original, unchanged function
+	original, err := g.Vertex(fn)
+	require.NoError(err)
+	assert.NotContains(original.Properties, "Timeout")
+	assert.Equal(map[string]any{"TABLE": "table"}, original.Properties["EnvironmentVariables"],
+		"the split functions must not share nested properties with the original")
+	_, err = g.Edge(items, fn)
+	assert.NoError(err)
+	_, err = g.Edge(api, reports)
+	assert.NoError(err)
+}
+
+func Test_SplitRouteFunctions_SingleRoute(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{
+			ID:         graphtest.ParseId(t, "aws:api_integration:api:reports"),
+			Properties: construct.Properties{"Route": "/reports"},
+		},
+		"aws:api_integration:api:reports -> aws:lambda_function:fn",
+	)
+	require.NoError(SplitRouteFunctions(g, &constraints.Constraints{}, kb, []RouteFunction{{Route: "/reports", Timeout: 900}}))
+
+	fns, err := construct.TopologicalSort(g)
+	require.NoError(err)
+	assert.Len(fns, 2, "a function serving only the route should not be split")
+
+	fn, err := g.Vertex(graphtest.ParseId(t, "aws:lambda_function:fn"))
+	require.NoError(err)
+	assert.Equal(900, fn.Properties["Timeout"])
+}
+
+func Test_SplitRouteFunctions_Solved(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	reports := graphtest.ParseId(t, "aws:api_integration:api:reports")
+	items := graphtest.ParseId(t, "aws:api_integration:api:items")
+	reportsPermission := graphtest.ParseId(t, "aws:lambda_permission:reports-fn")
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: reports, Properties: construct.Properties{"Route": "/reports", "Target": fn}},
+		&construct.Resource{ID: items, Properties: construct.Properties{"Route": "/items", "Target": fn}},
+		"aws:api_integration:api:reports -> aws:lambda_permission:reports-fn",
+		"aws:api_integration:api:items -> aws:lambda_permission:items-fn",
+		"aws:lambda_permission:reports-fn -> aws:lambda_function:fn",
+		"aws:lambda_permission:items-fn -> aws:lambda_function:fn",
+	)
+	c := &constraints.Constraints{}
+	require.NoError(SplitRouteFunctions(g, c, kb, []RouteFunction{{Route: "/reports", Timeout: 900}}))
+
+	reportsFn := graphtest.ParseId(t, "aws:lambda_function:fn-reports")
+	res, err := g.Vertex(reportsFn)
+	require.NoError(err)
+	assert.Equal(900, res.Properties["Timeout"])
+
+	_, err = g.Vertex(reportsPermission)
+	assert.ErrorIs(err, graph.ErrVertexNotFound, "the permission for the original function should be removed")
+	assert.Contains(c.Edges, constraints.EdgeConstraint{
+		Operator: constraints.MustExistConstraintOperator,
+		Target:   constraints.Edge{Source: reports, Target: reportsFn},
+	})
+	integration, err := g.Vertex(reports)
+	require.NoError(err)
+	assert.Equal(reportsFn, integration.Properties["Target"])
+
+	_, err = g.Edge(graphtest.ParseId(t, "aws:lambda_permission:items-fn"), fn)
+	assert.NoError(err, "the remaining route should keep its permission")
+}
+
+func Test_SplitRouteFunctions_Constraints(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	fn := graphtest.ParseId(t, "aws:lambda_function:fn")
+	bucket := graphtest.ParseId(t, "aws:s3_bucket:bucket")
+	reports := graphtest.ParseId(t, "aws:api_integration:api:reports")
+	items := graphtest.ParseId(t, "aws:api_integration:api:items")
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: reports, Properties: construct.Properties{"Route": "/reports"}},
+		&construct.Resource{ID: items, Properties: construct.Properties{"Route": "/items"}},
+		fn,
+	)
+	edge := func(source, target construct.ResourceId) constraints.EdgeConstraint {
+		return constraints.EdgeConstraint{
+			Operator: constraints.MustExistConstraintOperator,
+			Target:   constraints.Edge{Source: source, Target: target},
+		}
+	}
+	c := &constraints.Constraints{Edges: []constraints.EdgeConstraint{
+		edge(reports, fn),
+		edge(items, fn),
+		edge(fn, bucket),
+	}}
+	require.NoError(SplitRouteFunctions(g, c, kb, []RouteFunction{{Route: "/reports", Timeout: 900}}))
+
+	reportsFn := graphtest.ParseId(t, "aws:lambda_function:fn-reports")
+	assert.ElementsMatch([]constraints.EdgeConstraint{
+		edge(reports, reportsFn),
+		edge(items, fn),
+		edge(fn, bucket),
+		edge(reportsFn, bucket),
+	}, c.Edges)
+}
+
+func Test_SplitRouteFunctions_UnknownRoute(t *testing.T) {
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(t, err)
+	g := graphtest.MakeGraph(t, construct.NewGraph(), "aws:lambda_function:fn")
+	assert.Error(t, SplitRouteFunctions(g, &constraints.Constraints{}, kb, []RouteFunction{{Route: "/missing"}}))
+}
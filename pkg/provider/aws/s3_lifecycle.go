@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+)
+
+// minTransitionDays are the minimum number of days before objects can transition to a storage class, for the storage
+// classes which have one.
+var minTransitionDays = map[string]int{
+	"STANDARD_IA": 30,
+	"ONEZONE_IA":  30,
+}
+
+// CheckS3LifecycleRules returns an error for each S3 bucket lifecycle rule that S3 would reject when the bucket is
+// deployed: a transition without a storage class, a transition earlier than its storage class allows, or an
+// expiration which is not later than a transition for the same prefix (such as expiring objects before they've
+// transitioned to GLACIER).
+func CheckS3LifecycleRules(g construct.Graph) error {
+	return construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if id.Provider != "aws" || id.Type != "s3_bucket" {
+			return nerr
+		}
+		rules, _ := resource.Properties["LifecycleRules"].([]any)
+
+		type prefixDays struct {
+			latestTransition int
+			transitionClass  string
+			earliestExpire   int
+			hasTransition    bool
+			hasExpire        bool
+		}
+		byPrefix := make(map[string]*prefixDays)
+		for i, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			prefix, _ := rule["Prefix"].(string)
+			days := byPrefix[prefix]
+			if days == nil {
+				days = &prefixDays{}
+				byPrefix[prefix] = days
+			}
+			if transition, ok := lifecycleDays(rule["TransitionDays"]); ok {
+				class, _ := rule["StorageClass"].(string)
+				if class == "" {
+					nerr = errors.Join(nerr, fmt.Errorf("%s lifecycle rule %d transitions objects without a StorageClass", id, i))
+				} else if minDays, ok := minTransitionDays[class]; ok && transition < minDays {
+					nerr = errors.Join(nerr, fmt.Errorf(
+						"%s lifecycle rule %d transitions objects to %s after %d days but %s requires at least %d",
+						id, i, class, transition, class, minDays,
+					))
+				}
+				if !days.hasTransition || transition > days.latestTransition {
+					days.latestTransition = transition
+					days.transitionClass = class
+				}
+				days.hasTransition = true
+			}
+			if expire, ok := lifecycleDays(rule["ExpirationDays"]); ok {
+				if !days.hasExpire || expire < days.earliestExpire {
+					days.earliestExpire = expire
+				}
+				days.hasExpire = true
+			}
+		}
+
+		prefixes := make([]string, 0, len(byPrefix))
+		for prefix := range byPrefix {
+			prefixes = append(prefixes, prefix)
+		}
+		sort.Strings(prefixes)
+		for _, prefix := range prefixes {
+			days := byPrefix[prefix]
+			if days.hasTransition && days.hasExpire && days.earliestExpire <= days.latestTransition {
+				nerr = errors.Join(nerr, fmt.Errorf(
+					"%s lifecycle rules for prefix %q expire objects after %d days, which must be later than their transition to %s after %d days",
+					id, prefix, days.earliestExpire, days.transitionClass, days.latestTransition,
+				))
+			}
+		}
+		return nerr
+	})
+}
+
+func lifecycleDays(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	}
+	return 0, false
+}
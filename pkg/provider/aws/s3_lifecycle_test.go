@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckS3LifecycleRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []any
+		wantErr []string
+	}{
+		{
+			name: "no rules",
+		},
+		{
+			name: "glacier transition before expiration",
+			rules: []any{
+				map[string]any{"Prefix": "logs/", "TransitionDays": 30, "StorageClass": "GLACIER", "ExpirationDays": 365},
+			},
+		},
+		{
+			name: "expiration before glacier transition",
+			rules: []any{
+				map[string]any{"Prefix": "logs/", "TransitionDays": 90, "StorageClass": "GLACIER", "ExpirationDays": 90},
+			},
+			wantErr: []string{
+				`aws:s3_bucket:bucket lifecycle rules for prefix "logs/" expire objects after 90 days, which must be later than their transition to GLACIER after 90 days`,
+			},
+		},
+		{
+			name: "expiration and transition in separate rules for the same prefix",
+			rules: []any{
+				map[string]any{"Prefix": "logs/", "ExpirationDays": 30},
+				map[string]any{"Prefix": "logs/", "TransitionDays": 60, "StorageClass": "GLACIER"},
+				map[string]any{"Prefix": "tmp/", "ExpirationDays": 1},
+			},
+			wantErr: []string{
+				`prefix "logs/" expire objects after 30 days, which must be later than their transition to GLACIER after 60 days`,
+			},
+		},
+		{
+			name: "transition without storage class",
+			rules: []any{
+				map[string]any{"TransitionDays": 30},
+			},
+			wantErr: []string{"aws:s3_bucket:bucket lifecycle rule 0 transitions objects without a StorageClass"},
+		},
+		{
+			name: "infrequent access transition too early",
+			rules: []any{
+				map[string]any{"TransitionDays": 7, "StorageClass": "STANDARD_IA"},
+			},
+			wantErr: []string{"transitions objects to STANDARD_IA after 7 days but STANDARD_IA requires at least 30"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket := &construct.Resource{
+				ID:         construct.ResourceId{Provider: "aws", Type: "s3_bucket", Name: "bucket"},
+				Properties: construct.Properties{},
+			}
+			if tt.rules != nil {
+				bucket.Properties["LifecycleRules"] = tt.rules
+			}
+			g := graphtest.MakeGraph(t, construct.NewGraph(), bucket)
+
+			err := CheckS3LifecycleRules(g)
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+// unitRoleProperties maps the execution unit types to the property which holds the role their code runs as.
+var unitRoleProperties = map[string]string{
+	"lambda_function":     "ExecutionRole",
+	"ecs_task_definition": "TaskRole",
+	"app_runner_service":  "InstanceRole",
+}
+
+const awsManagedPolicyPrefix = "arn:aws:iam::aws:policy/"
+
+// PermissionSummary is the aggregated set of permissions an execution unit's role is allowed.
+type PermissionSummary struct {
+	Role construct.ResourceId
+
+	// Actions maps each allowed action to the sorted resources it is allowed on.
+	Actions map[string][]string
+
+	// AwsManagedPolicies are the ARNs of the AWS-managed policies attached to the role. Their actions are
+	// maintained by AWS and are not included in Actions.
+	AwsManagedPolicies []string
+}
+
+// UnitPermissions returns the effective permissions of the execution unit, combining the role's inline policies,
+// the customer-managed policies attached to it (either directly or via a policy attachment) and the AWS-managed
+// policies attached to it.
+func UnitPermissions(g construct.Graph, unit construct.ResourceId) (*PermissionSummary, error) {
+	roleProperty, ok := unitRoleProperties[unit.Type]
+	if unit.Provider != "aws" || !ok {
+		return nil, fmt.Errorf("%s is not a supported execution unit", unit)
+	}
+	unitRes, err := g.Vertex(unit)
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution unit %s: %w", unit, err)
+	}
+	roleVal, err := unitRes.GetProperty(roleProperty)
+	if err != nil {
+		return nil, err
+	}
+	roleId, ok := roleVal.(construct.ResourceId)
+	if !ok {
+		return nil, fmt.Errorf("execution unit %s has no role", unit)
+	}
+	role, err := g.Vertex(roleId)
+	if err != nil {
+		return nil, fmt.Errorf("could not get role %s: %w", roleId, err)
+	}
+
+	summary := &PermissionSummary{Role: roleId}
+	actions := make(map[string]set.Set[string])
+	addPolicy := func(policy any) {
+		for _, statement := range policyStatements(policy) {
+			if effect, ok := statement["Effect"].(string); ok && effect != "Allow" {
+				continue
+			}
+			resources := toStrings(statement["Resource"])
+			for _, action := range toStrings(statement["Action"]) {
+				if actions[action] == nil {
+					actions[action] = make(set.Set[string])
+				}
+				actions[action].Add(resources...)
+			}
+		}
+	}
+
+	var errs error
+	addCustomerPolicy := func(policyId construct.ResourceId) {
+		policyRes, err := g.Vertex(policyId)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not get policy %s: %w", policyId, err))
+			return
+		}
+		addPolicy(policyRes.Properties["Policy"])
+	}
+
+	inlinePolicies, _ := role.Properties["InlinePolicies"].([]any)
+	for _, inline := range inlinePolicies {
+		if inline, ok := inline.(map[string]any); ok {
+			addPolicy(inline["Policy"])
+		}
+	}
+
+	managed := make(set.Set[string])
+	for _, policy := range toList(role.Properties["ManagedPolicies"]) {
+		switch policy := policy.(type) {
+		case construct.PropertyRef:
+			addCustomerPolicy(policy.Resource)
+		case string:
+			if strings.HasPrefix(policy, awsManagedPolicyPrefix) {
+				managed.Add(policy)
+			}
+		}
+	}
+
+	// Policies can also be attached via an attachment resource which references the role
+	err = construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if id.Provider != "aws" || id.Type != "iam_role_policy_attachment" {
+			return nerr
+		}
+		if resource.Properties["Role"] != roleId {
+			return nerr
+		}
+		if policyId, ok := resource.Properties["Policy"].(construct.ResourceId); ok {
+			addCustomerPolicy(policyId)
+		}
+		return nerr
+	})
+	errs = errors.Join(errs, err)
+	if errs != nil {
+		return nil, errs
+	}
+
+	summary.Actions = make(map[string][]string, len(actions))
+	for action, resources := range actions {
+		list := resources.ToSlice()
+		sort.Strings(list)
+		summary.Actions[action] = list
+	}
+	summary.AwsManagedPolicies = managed.ToSlice()
+	sort.Strings(summary.AwsManagedPolicies)
+	return summary, nil
+}
+
+func policyStatements(policy any) []map[string]any {
+	policyMap, ok := policy.(map[string]any)
+	if !ok {
+		return nil
+	}
+	var statements []map[string]any
+	for _, statement := range toList(policyMap["Statement"]) {
+		if statement, ok := statement.(map[string]any); ok {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+func toList(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case set.HashedSet[string, any]:
+		return v.ToSlice()
+	case nil:
+		return nil
+	default:
+		return []any{v}
+	}
+}
+
+// toStrings converts policy values, which may be a single value or a list of strings and property references,
+// into their string forms.
+func toStrings(v any) []string {
+	if list, ok := v.([]string); ok {
+		return list
+	}
+	var strs []string
+	for _, item := range toList(v) {
+		strs = append(strs, fmt.Sprint(item))
+	}
+	return strs
+}
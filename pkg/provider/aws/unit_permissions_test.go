@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UnitPermissions(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	bucket := graphtest.ParseId(t, "aws:s3_bucket:bucket")
+	table := graphtest.ParseId(t, "aws:dynamodb_table:table")
+	role := graphtest.ParseId(t, "aws:iam_role:role")
+	unit := graphtest.ParseId(t, "aws:lambda_function:unit")
+
+	ctx := knowledgebase.DynamicValueContext{Graph: construct.NewGraph(), KnowledgeBase: kb}
+	bucketActions, err := ctx.AccessActions("read", bucket)
+	require.NoError(err)
+	tableActions, err := ctx.AccessActions("read", table)
+	require.NoError(err)
+
+	readPolicy := func(actions []string, target construct.ResourceId) map[string]any {
+		return map[string]any{
+			"Name": target.Name + "-policy",
+			"Policy": map[string]any{
+				"Version": "2012-10-17",
+				"Statement": []any{
+					map[string]any{
+						"Action":   actions,
+						"Effect":   "Allow",
+						"Resource": []any{construct.PropertyRef{Resource: target, Property: "Arn"}},
+					},
+				},
+			},
+		}
+	}
+
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		&construct.Resource{ID: bucket},
+		&construct.Resource{ID: table},
+		&construct.Resource{
+			ID: role,
+			Properties: construct.Properties{
+				"InlinePolicies": []any{
+					readPolicy(bucketActions, bucket),
+					readPolicy(tableActions, table),
+				},
+				"ManagedPolicies": []any{
+					"arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole",
+				},
+			},
+		},
+		&construct.Resource{
+			ID:         unit,
+			Properties: construct.Properties{"ExecutionRole": role},
+		},
+	)
+
+	summary, err := UnitPermissions(g, unit)
+	require.NoError(err)
+	assert.Equal(role, summary.Role)
+
+	for _, action := range bucketActions {
+		assert.Equal([]string{bucket.String() + "#Arn"}, summary.Actions[action], action)
+	}
+	for _, action := range tableActions {
+		assert.Equal([]string{table.String() + "#Arn"}, summary.Actions[action], action)
+	}
+	assert.Len(summary.Actions, len(bucketActions)+len(tableActions))
+	assert.Equal(
+		[]string{"arn:aws:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"},
+		summary.AwsManagedPolicies,
+	)
+
+	_, err = UnitPermissions(g, bucket)
+	assert.Error(err, "buckets are not execution units")
+}
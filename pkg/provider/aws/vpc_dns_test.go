@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/klothoplatform/klotho/pkg/knowledgebase"
+	"github.com/klothoplatform/klotho/pkg/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VpcDnsEnabledByDefault(t *testing.T) {
+	require := require.New(t)
+
+	kb, err := templates.NewKBFromTemplates()
+	require.NoError(err)
+
+	vpc := construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"}
+	g := graphtest.MakeGraph(t, construct.NewGraph(), vpc)
+
+	rt, err := kb.GetResourceTemplate(vpc)
+	require.NoError(err)
+
+	ctx := knowledgebase.DynamicValueContext{Graph: g, KnowledgeBase: kb}
+	for _, property := range []string{"EnableDnsHostnames", "EnableDnsSupport"} {
+		prop := rt.GetProperty(property)
+		require.NotNil(prop, property)
+
+		value, err := prop.GetDefaultValue(ctx, knowledgebase.DynamicValueData{Resource: vpc})
+		require.NoError(err, property)
+		assert.Equal(t, true, value, property)
+	}
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/engine/solution"
+	"github.com/klothoplatform/klotho/pkg/set"
+)
+
+var vpcType = construct.ResourceId{Provider: "aws", Type: "vpc"}
+
+type vpcEndpointService struct {
+	ServiceName     string
+	VpcEndpointType string
+}
+
+// vpcEndpointServices maps the resource types which can be reached privately to the
+// VPC endpoint service that provides the connection.
+var vpcEndpointServices = map[string]vpcEndpointService{
+	"s3_bucket":       {ServiceName: "s3", VpcEndpointType: "Gateway"},
+	"dynamodb_table":  {ServiceName: "dynamodb", VpcEndpointType: "Gateway"},
+	"lambda_function": {ServiceName: "lambda", VpcEndpointType: "Interface"},
+	"log_group":       {ServiceName: "logs", VpcEndpointType: "Interface"},
+	"secret":          {ServiceName: "secretsmanager", VpcEndpointType: "Interface"},
+	"sns_topic":       {ServiceName: "sns", VpcEndpointType: "Interface"},
+	"sqs_queue":       {ServiceName: "sqs", VpcEndpointType: "Interface"},
+}
+
+// VpcEndpoints returns the VPC endpoints for the given VPC that are needed by the resources in the VPC's subnets.
+// Only services used by at least one of those resources (one of the resource's downstream dependencies) get an
+// endpoint, which avoids creating unused (and billed) interface endpoints. Interface endpoints are placed in the VPC's
+// private subnets and gateway endpoints are associated with those subnets' route tables. The returned resources are
+// not added to the graph.
+func VpcEndpoints(g construct.Graph, vpc construct.ResourceId) ([]*construct.Resource, error) {
+	var allSubnets, subnets, routeTables []construct.ResourceId
+	err := construct.WalkGraph(g, func(id construct.ResourceId, resource *construct.Resource, nerr error) error {
+		if nerr != nil {
+			return nerr
+		}
+		if id.Provider != "aws" || id.Type != "subnet" || id.Namespace != vpc.Name {
+			return nil
+		}
+		allSubnets = append(allSubnets, id)
+		if resource.Properties["Type"] == "private" {
+			subnets = append(subnets, id)
+			if rt, ok := resource.Properties["RouteTable"].(construct.ResourceId); ok && !slices.Contains(routeTables, rt) {
+				routeTables = append(routeTables, rt)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	services := make(map[string]vpcEndpointService)
+	inVpc := make(set.Set[construct.ResourceId])
+	for _, subnet := range allSubnets {
+		inSubnet, err := construct.DirectUpstreamDependencies(g, subnet)
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range inSubnet {
+			if inVpc.Contains(res) {
+				continue
+			}
+			inVpc.Add(res)
+			deps, err := construct.AllDownstreamDependencies(g, res)
+			if err != nil {
+				return nil, err
+			}
+			for _, dep := range deps {
+				if svc, ok := vpcEndpointServices[dep.Type]; ok && dep.Provider == "aws" {
+					services[svc.ServiceName] = svc
+				}
+			}
+		}
+	}
+
+	sortIds := func(ids []construct.ResourceId) []any {
+		sort.Slice(ids, func(i, j int) bool { return construct.ResourceIdLess(ids[i], ids[j]) })
+		list := make([]any, len(ids))
+		for i, id := range ids {
+			list[i] = id
+		}
+		return list
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	endpoints := make([]*construct.Resource, 0, len(names))
+	for _, name := range names {
+		svc := services[name]
+		endpoint := &construct.Resource{
+			ID: construct.ResourceId{
+				Provider:  "aws",
+				Type:      "vpc_endpoint",
+				Namespace: vpc.Name,
+				Name:      svc.ServiceName,
+			},
+			Properties: construct.Properties{
+				"Vpc":             vpc,
+				"ServiceName":     svc.ServiceName,
+				"VpcEndpointType": svc.VpcEndpointType,
+			},
+		}
+		switch {
+		case svc.VpcEndpointType == "Interface" && len(subnets) > 0:
+			endpoint.Properties["Subnets"] = sortIds(subnets)
+		case svc.VpcEndpointType == "Gateway" && len(routeTables) > 0:
+			endpoint.Properties["RouteTables"] = sortIds(routeTables)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+// AddVpcEndpoints adds the [VpcEndpoints] of each VPC in the solution through its operational view, so the engine
+// configures the rest of their properties (such as their region) when the solution is next solved.
+func AddVpcEndpoints(sol solution.Solution) error {
+	var vpcs []construct.ResourceId
+	err := construct.WalkGraph(sol.DataflowGraph(), func(id construct.ResourceId, _ *construct.Resource, nerr error) error {
+		if vpcType.Matches(id) {
+			vpcs = append(vpcs, id)
+		}
+		return nerr
+	})
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, vpc := range vpcs {
+		endpoints, err := VpcEndpoints(sol.DataflowGraph(), vpc)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("could not determine endpoints for %s: %w", vpc, err))
+			continue
+		}
+		for _, endpoint := range endpoints {
+			err := sol.OperationalView().AddVertex(endpoint)
+			if errors.Is(err, graph.ErrVertexAlreadyExists) {
+				continue
+			} else if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("could not add %s: %w", endpoint.ID, err))
+				continue
+			}
+			// The subnets and route tables are deployed before the endpoint which uses them
+			for _, property := range []string{"Subnets", "RouteTables"} {
+				network, _ := endpoint.Properties[property].([]any)
+				for _, id := range network {
+					errs = errors.Join(errs, sol.OperationalView().AddEdge(id.(construct.ResourceId), endpoint.ID))
+				}
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/klothoplatform/klotho/pkg/construct"
+	"github.com/klothoplatform/klotho/pkg/construct/graphtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VpcEndpoints(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph []any
+		want  map[string]string
+	}{
+		{
+			name: "lambda in the VPC only talks to s3",
+			graph: []any{
+				"aws:vpc:vpc",
+				"aws:lambda_function:fn -> aws:subnet:vpc:subnet",
+				"aws:lambda_function:fn -> aws:iam_role:fn-role",
+				"aws:iam_role:fn-role -> aws:s3_bucket:bucket",
+				// used, but not from within the VPC
+				"aws:lambda_function:outside -> aws:sqs_queue:queue",
+				"aws:dynamodb_table:table",
+			},
+			want: map[string]string{"s3": "Gateway"},
+		},
+		{
+			name:  "no resources in the VPC",
+			graph: []any{"aws:vpc:vpc", "aws:subnet:vpc:subnet", "aws:lambda_function:fn -> aws:s3_bucket:bucket"},
+			want:  map[string]string{},
+		},
+		{
+			name: "resources in another VPC",
+			graph: []any{
+				"aws:vpc:vpc",
+				"aws:lambda_function:fn -> aws:subnet:other-vpc:subnet",
+				"aws:lambda_function:fn -> aws:s3_bucket:bucket",
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "multiple services used",
+			graph: []any{
+				"aws:vpc:vpc",
+				"aws:ecs_service:svc -> aws:subnet:vpc:subnet-a",
+				"aws:lambda_function:fn -> aws:subnet:vpc:subnet-b",
+				"aws:ecs_service:svc -> aws:dynamodb_table:table",
+				"aws:ecs_service:svc -> aws:sqs_queue:queue-a",
+				"aws:lambda_function:fn -> aws:sqs_queue:queue-b",
+			},
+			want: map[string]string{"dynamodb": "Gateway", "sqs": "Interface"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			g := graphtest.MakeGraph(t, construct.NewGraph(), tt.graph...)
+			vpc := construct.ResourceId{Provider: "aws", Type: "vpc", Name: "vpc"}
+
+			endpoints, err := VpcEndpoints(g, vpc)
+			require.NoError(err)
+
+			got := make(map[string]string)
+			for _, ep := range endpoints {
+				assert.Equal(vpc, ep.Properties["Vpc"])
+				got[ep.Properties["ServiceName"].(string)] = ep.Properties["VpcEndpointType"].(string)
+			}
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func Test_VpcEndpoints_Network(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	vpc := graphtest.ParseId(t, "aws:vpc:vpc")
+	private := graphtest.ParseId(t, "aws:subnet:vpc:private")
+	public := graphtest.ParseId(t, "aws:subnet:vpc:public")
+	rt := graphtest.ParseId(t, "aws:route_table:vpc:private-rt")
+	g := graphtest.MakeGraph(t, construct.NewGraph(),
+		vpc,
+		&construct.Resource{ID: private, Properties: construct.Properties{"Type": "private", "RouteTable": rt}},
+		&construct.Resource{ID: public, Properties: construct.Properties{"Type": "public"}},
+		"aws:lambda_function:fn -> aws:subnet:vpc:private",
+		"aws:lambda_function:fn -> aws:s3_bucket:bucket",
+		"aws:lambda_function:fn -> aws:sqs_queue:queue",
+	)
+
+	endpoints, err := VpcEndpoints(g, vpc)
+	require.NoError(err)
+	require.Len(endpoints, 2)
+	s3, sqs := endpoints[0], endpoints[1]
+	assert.Equal([]any{rt}, s3.Properties["RouteTables"])
+	assert.NotContains(s3.Properties, "Subnets")
+	assert.Equal([]any{private}, sqs.Properties["Subnets"])
+	assert.NotContains(sqs.Properties, "RouteTables")
+}